@@ -4,6 +4,8 @@ import (
 	"log"
 
 	"github.com/robertobouses/online-football-tycoon/cmd/migrations"
+	schedulerCmd "github.com/robertobouses/online-football-tycoon/cmd/scheduler"
+	seasonCmd "github.com/robertobouses/online-football-tycoon/cmd/season"
 	serverCmd "github.com/robertobouses/online-football-tycoon/cmd/server"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +18,8 @@ var rootCmd = &cobra.Command{
 func main() {
 	rootCmd.AddCommand(migrations.MigrationsCmd)
 	rootCmd.AddCommand(serverCmd.ServerCmd)
+	rootCmd.AddCommand(schedulerCmd.SchedulerCmd)
+	rootCmd.AddCommand(seasonCmd.EndSeasonCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatal(err)
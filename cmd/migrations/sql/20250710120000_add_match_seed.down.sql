@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE oft.match DROP COLUMN IF EXISTS seed;
+COMMIT;
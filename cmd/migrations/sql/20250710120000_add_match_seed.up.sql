@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE oft.match ADD COLUMN IF NOT EXISTS seed BIGINT;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE oft.classification RENAME TO classification_by_season;
+
+CREATE TABLE IF NOT EXISTS oft.classification (
+    team_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    points INT,
+    goals_for INT,
+    goals_against INT
+);
+
+INSERT INTO oft.classification (team_id, points, goals_for, goals_against)
+SELECT team_id, SUM(points), SUM(goals_for), SUM(goals_against)
+FROM oft.classification_by_season
+GROUP BY team_id;
+
+DROP TABLE oft.classification_by_season;
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+ALTER TABLE oft.classification RENAME TO classification_legacy;
+
+CREATE TABLE IF NOT EXISTS oft.classification (
+    season_id UUID NOT NULL REFERENCES oft.season(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES oft.team(id) ON DELETE CASCADE,
+    points INT NOT NULL DEFAULT 0,
+    goals_for INT NOT NULL DEFAULT 0,
+    goals_against INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (season_id, team_id)
+);
+
+-- The legacy standings carry over to each team's current league season, the
+-- one that started last; results played from now on update them. Teams that
+-- play in no league season have no standings to keep.
+INSERT INTO oft.classification (season_id, team_id, points, goals_for, goals_against)
+SELECT DISTINCT ON (cl.team_id)
+    st.season_id,
+    cl.team_id,
+    COALESCE(cl.points, 0),
+    COALESCE(cl.goals_for, 0),
+    COALESCE(cl.goals_against, 0)
+FROM oft.classification_legacy cl
+JOIN oft.season_team st ON st.team_id = cl.team_id
+JOIN oft.season s ON s.id = st.season_id
+JOIN oft.tournament t ON t.id = s.tournament_id
+WHERE t.type = 'League'
+ORDER BY cl.team_id, s.from_date DESC;
+
+DROP TABLE oft.classification_legacy;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE oft.classification
+    DROP COLUMN IF EXISTS played,
+    DROP COLUMN IF EXISTS won,
+    DROP COLUMN IF EXISTS drawn,
+    DROP COLUMN IF EXISTS lost,
+    DROP COLUMN IF EXISTS form,
+    DROP COLUMN IF EXISTS home_played,
+    DROP COLUMN IF EXISTS home_won,
+    DROP COLUMN IF EXISTS home_drawn,
+    DROP COLUMN IF EXISTS home_lost,
+    DROP COLUMN IF EXISTS home_goals_for,
+    DROP COLUMN IF EXISTS home_goals_against,
+    DROP COLUMN IF EXISTS home_points,
+    DROP COLUMN IF EXISTS away_played,
+    DROP COLUMN IF EXISTS away_won,
+    DROP COLUMN IF EXISTS away_drawn,
+    DROP COLUMN IF EXISTS away_lost,
+    DROP COLUMN IF EXISTS away_goals_for,
+    DROP COLUMN IF EXISTS away_goals_against,
+    DROP COLUMN IF EXISTS away_points;
+
+COMMIT;
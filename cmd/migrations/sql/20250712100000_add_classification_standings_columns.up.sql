@@ -0,0 +1,100 @@
+BEGIN;
+
+ALTER TABLE oft.classification
+    ADD COLUMN IF NOT EXISTS played INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS won INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS drawn INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS lost INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS form VARCHAR(5) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS home_played INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS home_won INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS home_drawn INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS home_lost INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS home_goals_for INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS home_goals_against INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS home_points INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS away_played INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS away_won INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS away_drawn INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS away_lost INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS away_goals_for INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS away_goals_against INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS away_points INT NOT NULL DEFAULT 0;
+
+WITH results AS (
+    SELECT
+        m.season_id,
+        m.home_team AS team_id,
+        TRUE AS is_home,
+        m.match_date,
+        m.home_result AS goals_for,
+        m.away_result AS goals_against
+    FROM oft.match m
+    WHERE m.home_result IS NOT NULL AND m.away_result IS NOT NULL
+    UNION ALL
+    SELECT
+        m.season_id,
+        m.away_team AS team_id,
+        FALSE AS is_home,
+        m.match_date,
+        m.away_result AS goals_for,
+        m.home_result AS goals_against
+    FROM oft.match m
+    WHERE m.home_result IS NOT NULL AND m.away_result IS NOT NULL
+),
+outcomes AS (
+    SELECT
+        r.*,
+        CASE WHEN r.goals_for > r.goals_against THEN 'W' WHEN r.goals_for = r.goals_against THEN 'D' ELSE 'L' END AS outcome,
+        ROW_NUMBER() OVER (PARTITION BY r.season_id, r.team_id ORDER BY r.match_date DESC) AS recency
+    FROM results r
+),
+totals AS (
+    SELECT
+        o.season_id,
+        o.team_id,
+        COUNT(*) AS played,
+        COUNT(*) FILTER (WHERE o.outcome = 'W') AS won,
+        COUNT(*) FILTER (WHERE o.outcome = 'D') AS drawn,
+        COUNT(*) FILTER (WHERE o.outcome = 'L') AS lost,
+        COUNT(*) FILTER (WHERE o.is_home) AS home_played,
+        COUNT(*) FILTER (WHERE o.is_home AND o.outcome = 'W') AS home_won,
+        COUNT(*) FILTER (WHERE o.is_home AND o.outcome = 'D') AS home_drawn,
+        COUNT(*) FILTER (WHERE o.is_home AND o.outcome = 'L') AS home_lost,
+        COALESCE(SUM(o.goals_for) FILTER (WHERE o.is_home), 0) AS home_goals_for,
+        COALESCE(SUM(o.goals_against) FILTER (WHERE o.is_home), 0) AS home_goals_against,
+        COUNT(*) FILTER (WHERE NOT o.is_home) AS away_played,
+        COUNT(*) FILTER (WHERE NOT o.is_home AND o.outcome = 'W') AS away_won,
+        COUNT(*) FILTER (WHERE NOT o.is_home AND o.outcome = 'D') AS away_drawn,
+        COUNT(*) FILTER (WHERE NOT o.is_home AND o.outcome = 'L') AS away_lost,
+        COALESCE(SUM(o.goals_for) FILTER (WHERE NOT o.is_home), 0) AS away_goals_for,
+        COALESCE(SUM(o.goals_against) FILTER (WHERE NOT o.is_home), 0) AS away_goals_against,
+        STRING_AGG(o.outcome, '' ORDER BY o.match_date ASC) FILTER (WHERE o.recency <= 5) AS form
+    FROM outcomes o
+    GROUP BY o.season_id, o.team_id
+)
+UPDATE oft.classification cl
+SET
+    played = t.played,
+    won = t.won,
+    drawn = t.drawn,
+    lost = t.lost,
+    form = COALESCE(t.form, ''),
+    home_played = t.home_played,
+    home_won = t.home_won,
+    home_drawn = t.home_drawn,
+    home_lost = t.home_lost,
+    home_goals_for = t.home_goals_for,
+    home_goals_against = t.home_goals_against,
+    home_points = t.home_won * 3 + t.home_drawn,
+    away_played = t.away_played,
+    away_won = t.away_won,
+    away_drawn = t.away_drawn,
+    away_lost = t.away_lost,
+    away_goals_for = t.away_goals_for,
+    away_goals_against = t.away_goals_against,
+    away_points = t.away_won * 3 + t.away_drawn
+FROM totals t
+WHERE cl.season_id = t.season_id AND cl.team_id = t.team_id;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE oft.tournament
+    DROP COLUMN IF EXISTS tie_breakers;
+
+COMMIT;
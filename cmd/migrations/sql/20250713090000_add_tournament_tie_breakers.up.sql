@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE oft.tournament
+    ADD COLUMN IF NOT EXISTS tie_breakers TEXT[] NOT NULL
+    DEFAULT ARRAY['goal_difference', 'goals_for', 'head_to_head_points', 'head_to_head_goal_difference', 'fair_play'];
+
+UPDATE oft.tournament
+SET tie_breakers = ARRAY['head_to_head_points', 'head_to_head_goal_difference', 'goal_difference', 'goals_for', 'fair_play']
+WHERE country_code = 'ESP' AND type = 'League';
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS oft.scheduler_checkpoint (
+    name VARCHAR(100) PRIMARY KEY,
+    last_run_at TIMESTAMP NOT NULL,
+    played INT NOT NULL DEFAULT 0,
+    failed INT NOT NULL DEFAULT 0,
+    skipped INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE oft.match
+    DROP COLUMN IF EXISTS away_penalties,
+    DROP COLUMN IF EXISTS home_penalties,
+    DROP COLUMN IF EXISTS extra_time,
+    DROP COLUMN IF EXISTS leg,
+    DROP COLUMN IF EXISTS cup_tie_id;
+
+DROP TABLE IF EXISTS oft.cup_tie;
+
+ALTER TABLE oft.tournament
+    DROP COLUMN IF EXISTS two_legged;
+
+COMMIT;
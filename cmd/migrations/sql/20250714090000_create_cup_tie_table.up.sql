@@ -0,0 +1,25 @@
+BEGIN;
+
+ALTER TABLE oft.tournament
+    ADD COLUMN IF NOT EXISTS two_legged BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS oft.cup_tie (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    season_id UUID NOT NULL REFERENCES oft.season(id) ON DELETE CASCADE,
+    round INT NOT NULL CHECK (round >= 1),
+    position INT NOT NULL CHECK (position >= 0),
+    home_team UUID NOT NULL REFERENCES oft.team(id) ON DELETE CASCADE,
+    away_team UUID REFERENCES oft.team(id) ON DELETE CASCADE,
+    winner_team UUID REFERENCES oft.team(id) ON DELETE SET NULL,
+    two_legged BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE (season_id, round, position)
+);
+
+ALTER TABLE oft.match
+    ADD COLUMN IF NOT EXISTS cup_tie_id UUID REFERENCES oft.cup_tie(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS leg INT,
+    ADD COLUMN IF NOT EXISTS extra_time BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS home_penalties INT,
+    ADD COLUMN IF NOT EXISTS away_penalties INT;
+
+COMMIT;
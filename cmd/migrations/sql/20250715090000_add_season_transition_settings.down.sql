@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE oft.season
+    DROP COLUMN IF EXISTS closed;
+
+ALTER TABLE oft.tournament
+    DROP COLUMN IF EXISTS playoff_spots,
+    DROP COLUMN IF EXISTS relegation_spots,
+    DROP COLUMN IF EXISTS promotion_spots;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE oft.tournament
+    ADD COLUMN IF NOT EXISTS promotion_spots INT NOT NULL DEFAULT 0 CHECK (promotion_spots >= 0),
+    ADD COLUMN IF NOT EXISTS relegation_spots INT NOT NULL DEFAULT 0 CHECK (relegation_spots >= 0),
+    ADD COLUMN IF NOT EXISTS playoff_spots INT NOT NULL DEFAULT 0 CHECK (playoff_spots >= 0);
+
+ALTER TABLE oft.season
+    ADD COLUMN IF NOT EXISTS closed BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE oft.tournament
+SET relegation_spots = 1
+WHERE name = 'Primera División';
+
+UPDATE oft.tournament
+SET promotion_spots = 1
+WHERE name = 'Segunda División';
+
+COMMIT;
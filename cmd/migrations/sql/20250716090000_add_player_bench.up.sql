@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE oft.player
+    ADD COLUMN IF NOT EXISTS benched BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE oft.player p
+SET lined = true
+WHERE p.team_id IN (
+    SELECT team_id
+    FROM oft.player
+    WHERE team_id IS NOT NULL
+    GROUP BY team_id
+    HAVING COUNT(*) = 11 AND NOT BOOL_OR(lined)
+);
+
+COMMIT;
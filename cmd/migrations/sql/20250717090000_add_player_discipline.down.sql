@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE oft.player
+    DROP COLUMN IF EXISTS suspended_matches,
+    DROP COLUMN IF EXISTS yellow_cards;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE oft.player
+    ADD COLUMN IF NOT EXISTS yellow_cards INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS suspended_matches INT NOT NULL DEFAULT 0;
+
+COMMIT;
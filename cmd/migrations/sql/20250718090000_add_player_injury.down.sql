@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE oft.player
+    DROP COLUMN IF EXISTS injury;
+
+COMMIT;
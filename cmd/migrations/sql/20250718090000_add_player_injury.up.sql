@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE oft.player
+    ADD COLUMN IF NOT EXISTS injury VARCHAR(20);
+
+UPDATE oft.player
+SET injurydays = 0
+WHERE injurydays IS NULL;
+
+COMMIT;
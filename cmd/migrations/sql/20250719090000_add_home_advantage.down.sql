@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE oft.tournament
+    DROP COLUMN IF EXISTS neutral_final,
+    DROP COLUMN IF EXISTS home_advantage;
+
+ALTER TABLE oft.country
+    DROP COLUMN IF EXISTS home_advantage;
+
+COMMIT;
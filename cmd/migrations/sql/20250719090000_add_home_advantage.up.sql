@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE oft.country
+    ADD COLUMN IF NOT EXISTS home_advantage NUMERIC(4, 2);
+
+ALTER TABLE oft.tournament
+    ADD COLUMN IF NOT EXISTS home_advantage NUMERIC(4, 2),
+    ADD COLUMN IF NOT EXISTS neutral_final BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE oft.tournament
+SET neutral_final = true
+WHERE type = 'Cup';
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE oft.match_events
+    DROP COLUMN IF EXISTS outcome,
+    DROP COLUMN IF EXISTS opponent_player_id,
+    DROP COLUMN IF EXISTS secondary_player_id,
+    DROP COLUMN IF EXISTS player_id;
+
+COMMIT;
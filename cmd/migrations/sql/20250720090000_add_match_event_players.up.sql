@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE oft.match_events
+    ADD COLUMN IF NOT EXISTS player_id UUID REFERENCES oft.player(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS secondary_player_id UUID REFERENCES oft.player(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS opponent_player_id UUID REFERENCES oft.player(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS outcome VARCHAR(20);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS oft.tactical_change;
+
+COMMIT;
@@ -0,0 +1,29 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS oft.tactical_change (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    strategy_id UUID NOT NULL REFERENCES oft.strategy(id) ON DELETE CASCADE,
+    score VARCHAR(10) NOT NULL CHECK (score IN ('losing', 'drawing', 'winning')),
+    from_minute INT NOT NULL DEFAULT 0 CHECK (from_minute >= 0),
+    playing_style VARCHAR(255),
+    game_tempo VARCHAR(255),
+    passing_style VARCHAR(255),
+    defensive_positioning VARCHAR(255),
+    build_up_play VARCHAR(255),
+    attack_focus VARCHAR(255),
+    key_player_usage VARCHAR(255)
+);
+
+INSERT INTO oft.tactical_change (strategy_id, score, from_minute, playing_style, game_tempo, passing_style, build_up_play)
+SELECT s.id, 'losing', 60, 'high_press', 'fast_tempo', 'long', 'long_clearance'
+FROM oft.strategy s
+JOIN oft.team t ON t.id = s.team_id
+WHERE t.name = 'Club Atlético Rocafuerte';
+
+INSERT INTO oft.tactical_change (strategy_id, score, from_minute, playing_style, game_tempo)
+SELECT s.id, 'winning', 75, 'low_block', 'slow_tempo'
+FROM oft.strategy s
+JOIN oft.team t ON t.id = s.team_id
+WHERE t.name = 'Club Deportivo Bahía Real';
+
+COMMIT;
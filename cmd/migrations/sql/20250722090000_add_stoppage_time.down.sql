@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE oft.match
+    DROP COLUMN IF EXISTS away_half_time_result,
+    DROP COLUMN IF EXISTS home_half_time_result;
+
+ALTER TABLE oft.match_events
+    ALTER COLUMN created_at SET DEFAULT NOW(),
+    DROP COLUMN IF EXISTS added_time;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE oft.match_events
+    ADD COLUMN IF NOT EXISTS added_time INT NOT NULL DEFAULT 0 CHECK (added_time >= 0),
+    ALTER COLUMN created_at SET DEFAULT clock_timestamp();
+
+ALTER TABLE oft.match
+    ADD COLUMN IF NOT EXISTS home_half_time_result INT,
+    ADD COLUMN IF NOT EXISTS away_half_time_result INT;
+
+COMMIT;
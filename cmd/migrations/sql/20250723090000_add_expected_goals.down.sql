@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE oft.match
+    DROP COLUMN IF EXISTS away_xg,
+    DROP COLUMN IF EXISTS home_xg;
+
+ALTER TABLE oft.match_events
+    DROP COLUMN IF EXISTS xg;
+
+COMMIT;
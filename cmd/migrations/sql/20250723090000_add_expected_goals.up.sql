@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE oft.match_events
+    ADD COLUMN IF NOT EXISTS xg NUMERIC(4, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE oft.match
+    ADD COLUMN IF NOT EXISTS home_xg NUMERIC(5, 2),
+    ADD COLUMN IF NOT EXISTS away_xg NUMERIC(5, 2);
+
+COMMIT;
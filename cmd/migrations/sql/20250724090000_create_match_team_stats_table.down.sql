@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS oft.match_team_stats;
+
+COMMIT;
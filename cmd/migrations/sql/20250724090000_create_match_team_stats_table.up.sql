@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS oft.match_team_stats (
+    match_id UUID NOT NULL REFERENCES oft.match(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES oft.team(id) ON DELETE CASCADE,
+    ball_possession INT NOT NULL DEFAULT 0,
+    scoring_chances INT NOT NULL DEFAULT 0,
+    shots INT NOT NULL DEFAULT 0,
+    shots_on_target INT NOT NULL DEFAULT 0,
+    corners INT NOT NULL DEFAULT 0,
+    fouls INT NOT NULL DEFAULT 0,
+    yellow_cards INT NOT NULL DEFAULT 0,
+    red_cards INT NOT NULL DEFAULT 0,
+    offsides INT NOT NULL DEFAULT 0,
+    saves INT NOT NULL DEFAULT 0,
+    passes INT NOT NULL DEFAULT 0,
+    passes_completed INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (match_id, team_id)
+);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS oft.player_match (
+    match_id UUID NOT NULL REFERENCES oft.match(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES oft.player(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES oft.team(id) ON DELETE CASCADE,
+    minutes INT NOT NULL DEFAULT 0,
+    rating NUMERIC(3,1) NOT NULL,
+    man_of_the_match BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (match_id, player_id)
+);
+
+COMMIT;
@@ -0,0 +1,82 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	appCup "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/cup"
+	appLineup "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/lineup"
+	appMatch "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	appScheduler "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/scheduler"
+	repositoryCup "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/cup"
+	repositoryLineup "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/lineup"
+	repositoryMatch "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/match"
+	repositoryScheduler "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/scheduler"
+	repositoryTeam "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/team"
+	repositoryTournament "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/tournament"
+	"github.com/robertobouses/online-football-tycoon/internal/pkg/config"
+	internalPostgres "github.com/robertobouses/online-football-tycoon/internal/pkg/postgres"
+	"github.com/spf13/cobra"
+)
+
+var SchedulerCmd = &cobra.Command{
+	Use:   "scheduler",
+	Short: "Plays due fixtures automatically",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := godotenv.Load()
+		if err != nil {
+			log.Fatal("failed to get env:", err)
+		}
+
+		db, err := internalPostgres.NewPostgres(internalPostgres.DBConfig{
+			User:     os.Getenv("DB_USER"),
+			Pass:     os.Getenv("DB_PASS"),
+			Host:     os.Getenv("DB_HOST"),
+			Port:     os.Getenv("DB_PORT"),
+			Database: os.Getenv("DB_NAME"),
+		})
+		if err != nil {
+			log.Fatal("failed to connect to database:", err)
+		}
+		matchRepo, err := repositoryMatch.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init match repository:", err)
+		}
+		teamRepo, err := repositoryTeam.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init team repository:", err)
+		}
+		tournamentRepo, err := repositoryTournament.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init tournament repository:", err)
+		}
+		cupRepo, err := repositoryCup.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init cup repository:", err)
+		}
+		lineupRepo, err := repositoryLineup.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init lineup repository:", err)
+		}
+		schedulerRepo, err := repositoryScheduler.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init scheduler repository:", err)
+		}
+
+		cupApp := appCup.NewApp(cupRepo, tournamentRepo)
+		lineupApp := appLineup.NewApp(lineupRepo)
+		matchApp := appMatch.NewApp(matchRepo, teamRepo, cupApp, lineupApp).WithEngine(config.MatchEngine())
+		schedulerApp := appScheduler.NewApp(matchApp, matchRepo, schedulerRepo, cupApp, config.Scheduler())
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := schedulerApp.Run(ctx); err != nil {
+			log.Fatal("scheduler failed:", err)
+		}
+	},
+}
@@ -0,0 +1,101 @@
+package season
+
+import (
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	appClassification "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/classification"
+	appCup "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/cup"
+	appSeason "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/season"
+	appTeam "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/team"
+	repositoryClassification "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/classification"
+	repositoryCup "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/cup"
+	repositoryMatch "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/match"
+	repositorySeason "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/season"
+	repositoryTeam "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/team"
+	repositoryTournament "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/tournament"
+	internalPostgres "github.com/robertobouses/online-football-tycoon/internal/pkg/postgres"
+	"github.com/spf13/cobra"
+)
+
+var seasonIDFlag string
+
+var EndSeasonCmd = &cobra.Command{
+	Use:   "end-season",
+	Short: "Closes a season, applies promotion and relegation and creates the next season",
+	Run: func(cmd *cobra.Command, args []string) {
+		seasonID, err := uuid.Parse(seasonIDFlag)
+		if err != nil {
+			log.Fatalf("invalid --season-id %q: %v", seasonIDFlag, err)
+		}
+
+		err = godotenv.Load()
+		if err != nil {
+			log.Fatal("failed to get env:", err)
+		}
+
+		db, err := internalPostgres.NewPostgres(internalPostgres.DBConfig{
+			User:     os.Getenv("DB_USER"),
+			Pass:     os.Getenv("DB_PASS"),
+			Host:     os.Getenv("DB_HOST"),
+			Port:     os.Getenv("DB_PORT"),
+			Database: os.Getenv("DB_NAME"),
+		})
+		if err != nil {
+			log.Fatal("failed to connect to database:", err)
+		}
+		matchRepo, err := repositoryMatch.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init match repository:", err)
+		}
+		teamRepo, err := repositoryTeam.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init team repository:", err)
+		}
+		classificationRepo, err := repositoryClassification.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init classification repository:", err)
+		}
+		tournamentRepo, err := repositoryTournament.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init tournament repository:", err)
+		}
+		cupRepo, err := repositoryCup.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init cup repository:", err)
+		}
+		seasonRepo, err := repositorySeason.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init season repository:", err)
+		}
+
+		cupApp := appCup.NewApp(cupRepo, tournamentRepo)
+		teamApp := appTeam.NewApp(teamRepo, *matchRepo, *tournamentRepo, cupApp)
+		classificationApp := appClassification.NewApp(classificationRepo, tournamentRepo, matchRepo)
+		seasonApp := appSeason.NewApp(seasonRepo, tournamentRepo, teamRepo, matchRepo, classificationApp, cupApp, teamApp)
+
+		transition, err := seasonApp.EndSeason(seasonID)
+		if err != nil {
+			log.Fatal("failed to end season:", err)
+		}
+
+		if transition.PlayoffsPending {
+			log.Println("Promotion play-offs scheduled; run end-season again once they are played")
+			return
+		}
+
+		for _, move := range transition.Moves {
+			log.Printf("Team %s moves from tournament %s to %s", move.TeamID, move.FromTournamentID, move.ToTournamentID)
+		}
+		for _, next := range transition.NextSeasons {
+			log.Printf("Created season %s for tournament %s (%s - %s)", next.ID, next.TournamentID, next.FromDate.Format("2006-01-02"), next.ToDate.Format("2006-01-02"))
+		}
+	},
+}
+
+func init() {
+	EndSeasonCmd.Flags().StringVar(&seasonIDFlag, "season-id", "", "season to close")
+	EndSeasonCmd.MarkFlagRequired("season-id")
+}
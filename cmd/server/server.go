@@ -1,28 +1,46 @@
 package server
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 	appClassification "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/classification"
 	appCountry "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/country"
+	appCup "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/cup"
+	appLineup "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/lineup"
 	appMatch "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
 	appPlayer "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/player"
+	appScheduler "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/scheduler"
+	appSeason "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/season"
 	appTeam "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/team"
 	appTournament "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/tournament"
 	httpServer "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http"
 	handlerClassification "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/classification"
 	handlerCountry "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/country"
+	handlerCup "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/cup"
+	handlerLineup "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/lineup"
 	handlerMatch "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/match"
 	handlerPlayer "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/player"
+	handlerSeason "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/season"
 	handlerTournament "github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/tournament"
 	repositoryClassification "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/classification"
 	repositoryCountry "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/country"
+	repositoryCup "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/cup"
+	repositoryLineup "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/lineup"
 	repositoryMatch "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/match"
 	repositoryPlayer "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/player"
+	repositoryScheduler "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/scheduler"
+	repositorySeason "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/season"
 	repositoryTeam "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/team"
 	repositoryTournament "github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/tournament"
+	"github.com/robertobouses/online-football-tycoon/internal/pkg/config"
+	"github.com/robertobouses/online-football-tycoon/internal/pkg/names"
 	internalPostgres "github.com/robertobouses/online-football-tycoon/internal/pkg/postgres"
 	"github.com/spf13/cobra"
 )
@@ -78,24 +96,83 @@ var ServerCmd = &cobra.Command{
 			log.Fatal("failde to init country repository:", err)
 
 		}
+		cupRepo, err := repositoryCup.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init cup repository:", err)
+		}
+		seasonRepo, err := repositorySeason.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init season repository:", err)
+		}
+
+		lineupRepo, err := repositoryLineup.NewRepository(db)
+		if err != nil {
+			log.Fatal("failed to init lineup repository:", err)
+		}
+
+		nameGenerator, err := newNameGenerator()
+		if err != nil {
+			log.Fatal("failed to init name generator:", err)
+		}
 
-		matchApp := appMatch.NewApp(matchRepo, classificationRepo, teamRepo)
-		playerApp := appPlayer.NewApp(playerRepo)
-		teamApp := appTeam.NewApp(teamRepo, *matchRepo, *tournamentRepo)
-		classificationApp := appClassification.NewApp(classificationRepo, tournamentRepo)
+		cupApp := appCup.NewApp(cupRepo, tournamentRepo)
+		lineupApp := appLineup.NewApp(lineupRepo)
+		matchApp := appMatch.NewApp(matchRepo, teamRepo, cupApp, lineupApp).WithEngine(config.MatchEngine())
+		playerApp := appPlayer.NewApp(playerRepo, nameGenerator)
+		teamApp := appTeam.NewApp(teamRepo, *matchRepo, *tournamentRepo, cupApp)
+		classificationApp := appClassification.NewApp(classificationRepo, tournamentRepo, matchRepo)
 		countryApp := appCountry.NewApp(countryRepo)
 		tournamentApp := appTournament.NewApp(tournamentRepo)
+		seasonApp := appSeason.NewApp(seasonRepo, tournamentRepo, teamRepo, matchRepo, classificationApp, cupApp, teamApp)
+
+		if os.Getenv("SCHEDULER_ENABLED") == "true" {
+			schedulerRepo, err := repositoryScheduler.NewRepository(db)
+			if err != nil {
+				log.Fatal("failed to init scheduler repository:", err)
+			}
+			schedulerApp := appScheduler.NewApp(matchApp, matchRepo, schedulerRepo, cupApp, config.Scheduler())
+			go func() {
+				if err := schedulerApp.Run(context.Background()); err != nil {
+					log.Printf("scheduler stopped: %v", err)
+				}
+			}()
+		}
 
 		matchHandler := handlerMatch.NewHandler(&matchApp, teamApp)
 		playerHandler := handlerPlayer.NewHandler(playerApp)
 		classificationHandler := handlerClassification.NewHandler(classificationApp)
 		countryHandler := handlerCountry.NewHandler(countryApp)
 		tournamentHandler := handlerTournament.NewHandler(tournamentApp)
+		cupHandler := handlerCup.NewHandler(cupApp)
+		seasonHandler := handlerSeason.NewHandler(seasonApp)
+		lineupHandler := handlerLineup.NewHandler(lineupApp)
 
-		s := httpServer.NewServer(matchHandler, playerHandler, classificationHandler, countryHandler, *tournamentHandler)
+		s := httpServer.NewServer(matchHandler, playerHandler, classificationHandler, countryHandler, *tournamentHandler, cupHandler, seasonHandler, lineupHandler)
 
 		if err := s.Run("8080"); err != nil {
 			log.Fatal("server failed:", err)
 		}
 	},
 }
+
+func newNameGenerator() (names.Generator, error) {
+	seed := uint64(time.Now().UnixNano())
+	if value := os.Getenv("NAME_SEED"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NAME_SEED=%q: %w", value, err)
+		}
+		seed = parsed
+	}
+
+	corpus, err := names.NewCorpusGenerator(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	if os.Getenv("NAME_PROVIDER") == "randomuser" {
+		return names.NewRandomUserGenerator(&http.Client{Timeout: 5 * time.Second}, corpus), nil
+	}
+
+	return corpus, nil
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "github.com/google/uuid"
+
+// FitnessRecoveryPerMatchday is the fitness every player of a team gets back
+// each time the team plays a match.
+const FitnessRecoveryPerMatchday = 12
+
+// Appearance is a player's part in a match: the minutes on the pitch and the
+// fitness they cost.
+type Appearance struct {
+	PlayerID    uuid.UUID
+	TeamID      uuid.UUID
+	Minutes     int
+	FitnessLoss int
+}
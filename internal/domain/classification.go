@@ -2,12 +2,36 @@ package domain
 
 import "github.com/google/uuid"
 
+const (
+	ResultWin  = "W"
+	ResultDraw = "D"
+	ResultLoss = "L"
+)
+
 type Classification struct {
+	SeasonID       uuid.UUID
 	TeamID         uuid.UUID
 	TeamName       string
 	Position       int
 	Points         int
+	Played         int
+	Won            int
+	Drawn          int
+	Lost           int
 	GoalsFor       int
 	GoalsAgainst   int
 	GoalDifference int
+	Form           string
+	Home           ClassificationSplit
+	Away           ClassificationSplit
+}
+
+type ClassificationSplit struct {
+	Played       int
+	Won          int
+	Drawn        int
+	Lost         int
+	GoalsFor     int
+	GoalsAgainst int
+	Points       int
 }
@@ -0,0 +1,15 @@
+package domain
+
+import "github.com/google/uuid"
+
+type CupTie struct {
+	ID           uuid.UUID
+	SeasonID     uuid.UUID
+	Round        int
+	Position     int
+	HomeTeamID   uuid.UUID
+	AwayTeamID   *uuid.UUID
+	WinnerTeamID *uuid.UUID
+	TwoLegged    bool
+	Matches      []SeasonMatch
+}
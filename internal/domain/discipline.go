@@ -0,0 +1,33 @@
+package domain
+
+import "github.com/google/uuid"
+
+type Card string
+
+const (
+	CardYellow Card = "yellow"
+	CardRed    Card = "red"
+)
+
+const (
+	// YellowCardsForSuspension yellow cards collected over several matches
+	// cost a one match ban.
+	YellowCardsForSuspension = 5
+	RedCardSuspension        = 1
+)
+
+type Booking struct {
+	PlayerID     uuid.UUID
+	TeamID       uuid.UUID
+	Minute       int
+	Card         Card
+	SecondYellow bool
+}
+
+// PlayerDiscipline is what a match adds to a player's record: yellow cards
+// that count towards a ban and matches the player is banned for.
+type PlayerDiscipline struct {
+	PlayerID         uuid.UUID
+	YellowCards      int
+	SuspendedMatches int
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "github.com/google/uuid"
+
+type InjurySeverity string
+
+const (
+	InjuryMinor    InjurySeverity = "minor"
+	InjuryModerate InjurySeverity = "moderate"
+	InjurySerious  InjurySeverity = "serious"
+)
+
+// RecoveryDaysPerMatchday is how much an injured player recovers each time
+// the team plays a match.
+const RecoveryDaysPerMatchday = 7
+
+type Injury struct {
+	PlayerID uuid.UUID
+	TeamID   uuid.UUID
+	Minute   int
+	Severity InjurySeverity
+	Days     int
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+const (
+	StartingPlayers = 11
+	MaxBenchPlayers = 7
+)
+
+// Formations are the shapes the match engine knows how to play.
+var Formations = []string{"4-4-2", "4-3-3", "4-5-1", "5-4-1", "5-3-2", "3-4-3", "3-5-2"}
+
+var (
+	ErrInvalidLineup = errors.New("invalid lineup")
+	ErrLineupNotSet  = errors.New("team has no lineup")
+)
+
+type Lineup struct {
+	TeamID    uuid.UUID
+	Formation string
+	Starters  []Player
+	Bench     []Player
+}
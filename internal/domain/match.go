@@ -1,22 +1,59 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var ErrMatchAlreadyPlayed = errors.New("match already played")
+
+// DefaultHomeAdvantage applies when neither the tournament nor its country
+// sets one. 0 means a neutral venue.
+const DefaultHomeAdvantage = 1.0
+
 type Match struct {
 	HomeMatchStrategy Strategy
 	AwayMatchStrategy Strategy
+	HomeAdvantage     float64
+}
+
+// Venue is how strong the home edge is in a tournament and whether its cup
+// final is played on neutral ground.
+type Venue struct {
+	HomeAdvantage float64
+	NeutralFinal  bool
 }
 
 type SeasonMatch struct {
-	ID         uuid.UUID
-	SeasonID   uuid.UUID
-	HomeTeamID uuid.UUID
-	AwayTeamID uuid.UUID
-	MatchDate  time.Time
-	HomeResult *int
-	AwayResult *int
+	ID            uuid.UUID
+	SeasonID      uuid.UUID
+	HomeTeamID    uuid.UUID
+	AwayTeamID    uuid.UUID
+	MatchDate     time.Time
+	HomeResult    *int
+	AwayResult    *int
+	HomeHalfTime  *int
+	AwayHalfTime  *int
+	HomeXG        *float64
+	AwayXG        *float64
+	Seed          *int64
+	CupTieID      *uuid.UUID
+	Leg           int
+	ExtraTime     bool
+	HomePenalties *int
+	AwayPenalties *int
+}
+
+type MatchRecord struct {
+	Match           SeasonMatch
+	Events          []MatchEventInfo
+	Classifications []Classification
+	CupTie          *CupTie
+	Discipline      []PlayerDiscipline
+	Injuries        []Injury
+	Appearances     []Appearance
+	TeamStats       []MatchTeamStats
+	Ratings         []PlayerRating
 }
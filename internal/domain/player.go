@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
 
 type Player struct {
 	PlayerId    uuid.UUID
@@ -15,10 +19,15 @@ type Player struct {
 	Mental      int
 	Physique    int
 	InjuryDays  int
+	Injury      InjurySeverity
 	Lined       bool
+	Benched     bool
 	Familiarity int
 	Fitness     int
 	Happiness   int
+
+	YellowCards      int
+	SuspendedMatches int
 }
 
 const (
@@ -27,3 +36,21 @@ const (
 	PositionMidfielder = "midfielder"
 	PositionForward    = "forward"
 )
+
+var (
+	ErrTeamNotFound         = errors.New("team not found")
+	ErrInvalidQualityBand   = errors.New("invalid quality band")
+	ErrInvalidNationalities = errors.New("nationalities need a country and a positive total weight")
+	ErrUnknownCountry       = errors.New("unknown country")
+)
+
+// QualityBand bounds the average of technique, mental and physique.
+type QualityBand struct {
+	Min int
+	Max int
+}
+
+type NationalityShare struct {
+	Country string
+	Weight  int
+}
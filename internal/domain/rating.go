@@ -0,0 +1,26 @@
+package domain
+
+import "github.com/google/uuid"
+
+// PlayerRating is how well a player did in a match, from 1 to 10.
+type PlayerRating struct {
+	MatchID       uuid.UUID
+	PlayerID      uuid.UUID
+	TeamID        uuid.UUID
+	Minutes       int
+	Rating        float64
+	ManOfTheMatch bool
+}
+
+// SeasonRating is a player's average rating over the matches they played in
+// a season.
+type SeasonRating struct {
+	PlayerID      uuid.UUID
+	TeamID        uuid.UUID
+	FirstName     string
+	LastName      string
+	Matches       int
+	Minutes       int
+	Rating        float64
+	ManOfTheMatch int
+}
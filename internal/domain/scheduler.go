@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+type SchedulerCheckpoint struct {
+	Name      string
+	LastRunAt time.Time
+	Played    int
+	Failed    int
+	Skipped   int
+}
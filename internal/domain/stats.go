@@ -1,8 +1,13 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
 
 type Result struct {
+	Seed      int64
 	HomeStats TeamStats
 	AwayStats TeamStats
 }
@@ -11,6 +16,37 @@ type TeamStats struct {
 	BallPossession int
 	ScoringChances int
 	Goals          int
+	HalfTimeGoals  int
+	// XG is the expected goals of the team's shots, the goals its chances
+	// were worth.
+	XG              float64
+	Shots           int
+	ShotsOnTarget   int
+	Corners         int
+	Fouls           int
+	YellowCards     int
+	RedCards        int
+	Offsides        int
+	Saves           int
+	Passes          int
+	PassesCompleted int
+	// PassSuccess is the percentage of passes completed.
+	PassSuccess int
+}
+
+// PassPercentage is the percentage of passes completed, 0 without passes.
+func PassPercentage(completed, passes int) int {
+	if passes == 0 {
+		return 0
+	}
+	return 100 * completed / passes
+}
+
+// MatchTeamStats are the stats of a team in a match.
+type MatchTeamStats struct {
+	MatchID uuid.UUID
+	TeamID  uuid.UUID
+	TeamStats
 }
 
 type MatchEventStats struct {
@@ -20,26 +56,93 @@ type MatchEventStats struct {
 	AwayScoreChances int
 	HomeGoals        int
 	AwayGoals        int
+	HomeXG           float64
+	AwayXG           float64
+	// AddedTime is the stoppage time of each half of the period.
+	AddedTime []int
 }
 
 type MatchEventInfo struct {
-	ID          uuid.UUID
-	MatchID     uuid.UUID
-	TeamId      uuid.UUID
-	EventType   string
-	Minute      int
-	Description string
+	ID                uuid.UUID
+	MatchID           uuid.UUID
+	TeamId            uuid.UUID
+	EventType         string
+	Minute            int
+	AddedTime         int
+	Description       string
+	XG                float64
+	PlayerID          *uuid.UUID
+	SecondaryPlayerID *uuid.UUID
+	OpponentPlayerID  *uuid.UUID
+	Outcome           Outcome
+}
+
+// Outcome is how a match event ended.
+type Outcome string
+
+const (
+	OutcomeGoal      Outcome = "goal"
+	OutcomeSaved     Outcome = "saved"
+	OutcomeMissed    Outcome = "missed"
+	OutcomeBlocked   Outcome = "blocked"
+	OutcomeCompleted Outcome = "completed"
+	OutcomeFailed    Outcome = "failed"
+	OutcomeOffside   Outcome = "offside"
+)
+
+// EventActors are the players taking part in an event: the player who acts
+// (the scorer of a goal), a teammate who helps (the assister) and the rival
+// who faces them (the goalkeeper or the defender).
+type EventActors struct {
+	PlayerID          *uuid.UUID `json:"player_id,omitempty"`
+	SecondaryPlayerID *uuid.UUID `json:"secondary_player_id,omitempty"`
+	OpponentPlayerID  *uuid.UUID `json:"opponent_player_id,omitempty"`
+	Outcome           Outcome    `json:"outcome,omitempty"`
+}
+
+// EventOutcome is what an event produced for the team playing it and for its
+// rival. FollowUps are the events it led to, such as the shot after a key pass.
+type EventOutcome struct {
+	Sentence      string
+	LineupChances int
+	RivalChances  int
+	LineupGoals   int
+	RivalGoals    int
+	// XG is the chance the shot of the event had of being a goal.
+	XG float64
+	EventActors
+	FollowUps []FollowUp
+}
+
+// FollowUp is an event that came out of another one. Rival is set when the
+// rival team plays it, like a counterattack after a lost header.
+type FollowUp struct {
+	EventType string
+	Rival     bool
+	EventOutcome
 }
 
 type Event struct {
 	Name    string
-	Execute func() (string, int, int, int, int, error)
+	Execute func() (EventOutcome, error)
 }
 
 type EventResult struct {
 	Event     string    `json:"event"`
 	Minute    int       `json:"minute"`
+	AddedTime int       `json:"added_time,omitempty"`
 	EventType string    `json:"eventtype"`
 	TeamId    uuid.UUID `json:"teamid"`
 	TeamName  string    `json:"team"`
+	XG        float64   `json:"xg,omitempty"`
+	EventActors
+}
+
+// MinuteLabel is how a minute reads on the scoreboard, like "45+2" for the
+// second minute of stoppage time of the first half.
+func MinuteLabel(minute, addedTime int) string {
+	if addedTime > 0 {
+		return fmt.Sprintf("%d+%d", minute, addedTime)
+	}
+	return fmt.Sprintf("%d", minute)
 }
@@ -1,5 +1,7 @@
 package domain
 
+import "strings"
+
 type Strategy struct {
 	StrategyTeam         Team
 	Formation            string
@@ -10,4 +12,75 @@ type Strategy struct {
 	BuildUpPlay          string
 	AttackFocus          string
 	KeyPlayerUsage       string
+	TacticalChanges      []TacticalChange
+}
+
+// ScoreState is how a team stands in the match.
+type ScoreState string
+
+const (
+	ScoreLosing  ScoreState = "losing"
+	ScoreDrawing ScoreState = "drawing"
+	ScoreWinning ScoreState = "winning"
+)
+
+func ScoreStateOf(goalDifference int) ScoreState {
+	switch {
+	case goalDifference < 0:
+		return ScoreLosing
+	case goalDifference > 0:
+		return ScoreWinning
+	}
+	return ScoreDrawing
+}
+
+// TacticalChange is an instruction a team follows during a match, such as
+// "if losing after minute 60 switch to high_press and fast_tempo". Empty
+// fields keep what the team was doing. The formation stays, as the lineup is
+// picked for it.
+type TacticalChange struct {
+	Score                ScoreState
+	FromMinute           int
+	PlayingStyle         string
+	GameTempo            string
+	PassingStyle         string
+	DefensivePositioning string
+	BuildUpPlay          string
+	AttackFocus          string
+	KeyPlayerUsage       string
+}
+
+func (c TacticalChange) Triggered(minute, goalDifference int) bool {
+	return minute >= c.FromMinute && ScoreStateOf(goalDifference) == c.Score
+}
+
+func (c TacticalChange) Apply(s Strategy) Strategy {
+	for _, field := range []struct {
+		value  string
+		target *string
+	}{
+		{c.PlayingStyle, &s.PlayingStyle},
+		{c.GameTempo, &s.GameTempo},
+		{c.PassingStyle, &s.PassingStyle},
+		{c.DefensivePositioning, &s.DefensivePositioning},
+		{c.BuildUpPlay, &s.BuildUpPlay},
+		{c.AttackFocus, &s.AttackFocus},
+		{c.KeyPlayerUsage, &s.KeyPlayerUsage},
+	} {
+		if field.value != "" {
+			*field.target = field.value
+		}
+	}
+	return s
+}
+
+// Instructions lists the options the change switches to.
+func (c TacticalChange) Instructions() string {
+	var instructions []string
+	for _, value := range []string{c.PlayingStyle, c.GameTempo, c.PassingStyle, c.DefensivePositioning, c.BuildUpPlay, c.AttackFocus, c.KeyPlayerUsage} {
+		if value != "" {
+			instructions = append(instructions, value)
+		}
+	}
+	return strings.Join(instructions, ", ")
 }
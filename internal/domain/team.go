@@ -7,4 +7,5 @@ type Team struct {
 	Name    string
 	Country string
 	Players []Player
+	Bench   []Player
 }
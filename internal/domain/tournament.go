@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,21 +14,64 @@ const (
 	TournamentCup    TournamentType = "Cup"
 )
 
+type TieBreaker string
+
+const (
+	TieBreakerGoalDifference           TieBreaker = "goal_difference"
+	TieBreakerGoalsFor                 TieBreaker = "goals_for"
+	TieBreakerAwayGoalsFor             TieBreaker = "away_goals_for"
+	TieBreakerHeadToHeadPoints         TieBreaker = "head_to_head_points"
+	TieBreakerHeadToHeadGoalDifference TieBreaker = "head_to_head_goal_difference"
+	TieBreakerFairPlay                 TieBreaker = "fair_play"
+)
+
+var DefaultTieBreakers = []TieBreaker{
+	TieBreakerGoalDifference,
+	TieBreakerGoalsFor,
+	TieBreakerHeadToHeadPoints,
+	TieBreakerHeadToHeadGoalDifference,
+	TieBreakerFairPlay,
+}
+
 type Tournament struct {
-	ID          uuid.UUID
-	Name        string
-	Type        TournamentType
-	CountryCode string
-	Division    int
-	PromotionTo *uuid.UUID
-	DescentTo   *uuid.UUID
+	ID              uuid.UUID
+	Name            string
+	Type            TournamentType
+	CountryCode     string
+	Division        int
+	PromotionTo     *uuid.UUID
+	DescentTo       *uuid.UUID
+	TieBreakers     []TieBreaker
+	TwoLegged       bool
+	PromotionSpots  int
+	RelegationSpots int
+	PlayoffSpots    int
 }
 
+var (
+	ErrSeasonNotFinished = errors.New("season has unplayed matches")
+	ErrSeasonClosed      = errors.New("season already closed")
+)
+
 type Season struct {
 	ID           uuid.UUID
 	TournamentID uuid.UUID
 	FromDate     time.Time
 	ToDate       time.Time
+	Closed       bool
+}
+
+type TeamMove struct {
+	TeamID           uuid.UUID
+	FromTournamentID uuid.UUID
+	ToTournamentID   uuid.UUID
+}
+
+type SeasonTransition struct {
+	ClosedSeasons   []uuid.UUID
+	NextSeasons     []Season
+	Moves           []TeamMove
+	PlayoffsPending bool
 }
 
 type SeasonTeam struct {
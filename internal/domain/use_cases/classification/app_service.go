@@ -7,20 +7,27 @@ import (
 
 type ClassificationRepository interface {
 	GetClassification(seasonID uuid.UUID) ([]domain.Classification, error)
+	GetFairPlayPoints(seasonID uuid.UUID) (map[uuid.UUID]int, error)
 }
 
 type TournamentRepository interface {
 	GetTournamentBySeasonID(seasonID uuid.UUID) (domain.Tournament, error)
 }
 
-func NewApp(classificationRepository ClassificationRepository, tournamentRepository TournamentRepository) AppService {
+type MatchRepository interface {
+	GetSeasonMatches(seasonID uuid.UUID) ([]domain.SeasonMatch, error)
+}
+
+func NewApp(classificationRepository ClassificationRepository, tournamentRepository TournamentRepository, matchRepository MatchRepository) AppService {
 	return AppService{
 		classificationRepo: classificationRepository,
 		tournamentRepo:     tournamentRepository,
+		matchRepo:          matchRepository,
 	}
 }
 
 type AppService struct {
 	classificationRepo ClassificationRepository
 	tournamentRepo     TournamentRepository
+	matchRepo          MatchRepository
 }
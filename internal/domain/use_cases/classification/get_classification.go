@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
 type Classification struct {
@@ -17,9 +18,16 @@ type TeamClassification struct {
 	TeamName       string
 	Position       int
 	Points         int
+	Played         int
+	Won            int
+	Drawn          int
+	Lost           int
 	GoalsFor       int
 	GoalsAgainst   int
 	GoalDifference int
+	Form           string
+	Home           domain.ClassificationSplit
+	Away           domain.ClassificationSplit
 }
 
 func (a AppService) GetClassification(seasonID uuid.UUID) ([]Classification, error) {
@@ -34,6 +42,20 @@ func (a AppService) GetClassification(seasonID uuid.UUID) ([]Classification, err
 		return nil, err
 	}
 
+	matches, err := a.matchRepo.GetSeasonMatches(seasonID)
+	if err != nil {
+		log.Println("Error Get Season Matches on GetClassification")
+		return nil, err
+	}
+
+	fairPlayPoints, err := a.classificationRepo.GetFairPlayPoints(seasonID)
+	if err != nil {
+		log.Println("Error Get Fair Play Points on GetClassification")
+		return nil, err
+	}
+
+	classification = RankClassification(classification, matches, fairPlayPoints, tournament.TieBreakers)
+
 	teams := make([]TeamClassification, 0, len(classification))
 	for _, c := range classification {
 		teams = append(teams, TeamClassification{
@@ -41,9 +63,16 @@ func (a AppService) GetClassification(seasonID uuid.UUID) ([]Classification, err
 			TeamName:       c.TeamName,
 			Position:       c.Position,
 			Points:         c.Points,
+			Played:         c.Played,
+			Won:            c.Won,
+			Drawn:          c.Drawn,
+			Lost:           c.Lost,
 			GoalsFor:       c.GoalsFor,
 			GoalsAgainst:   c.GoalsAgainst,
 			GoalDifference: c.GoalDifference,
+			Form:           c.Form,
+			Home:           c.Home,
+			Away:           c.Away,
 		})
 	}
 
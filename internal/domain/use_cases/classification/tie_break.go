@@ -0,0 +1,152 @@
+package classification
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type headToHeadRecord struct {
+	points         int
+	goalDifference int
+}
+
+func RankClassification(classification []domain.Classification, matches []domain.SeasonMatch, fairPlayPoints map[uuid.UUID]int, tieBreakers []domain.TieBreaker) []domain.Classification {
+	if len(tieBreakers) == 0 {
+		tieBreakers = domain.DefaultTieBreakers
+	}
+
+	sorted := make([]domain.Classification, len(classification))
+	copy(sorted, classification)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Points > sorted[j].Points
+	})
+
+	ranked := make([]domain.Classification, 0, len(sorted))
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Points == sorted[start].Points {
+			end++
+		}
+		ranked = append(ranked, breakTie(sorted[start:end], matches, fairPlayPoints, tieBreakers)...)
+		start = end
+	}
+
+	for i := range ranked {
+		ranked[i].Position = i + 1
+	}
+
+	return ranked
+}
+
+func breakTie(group []domain.Classification, matches []domain.SeasonMatch, fairPlayPoints map[uuid.UUID]int, tieBreakers []domain.TieBreaker) []domain.Classification {
+	sorted := make([]domain.Classification, len(group))
+	copy(sorted, group)
+
+	if len(sorted) <= 1 {
+		return sorted
+	}
+
+	if len(tieBreakers) == 0 {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if sorted[i].TeamName != sorted[j].TeamName {
+				return sorted[i].TeamName < sorted[j].TeamName
+			}
+			return sorted[i].TeamID.String() < sorted[j].TeamID.String()
+		})
+		return sorted
+	}
+
+	values := tieBreakValues(sorted, matches, fairPlayPoints, tieBreakers[0])
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return values[sorted[i].TeamID] > values[sorted[j].TeamID]
+	})
+
+	ranked := make([]domain.Classification, 0, len(sorted))
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && values[sorted[end].TeamID] == values[sorted[start].TeamID] {
+			end++
+		}
+		ranked = append(ranked, breakTie(sorted[start:end], matches, fairPlayPoints, tieBreakers[1:])...)
+		start = end
+	}
+
+	return ranked
+}
+
+func tieBreakValues(group []domain.Classification, matches []domain.SeasonMatch, fairPlayPoints map[uuid.UUID]int, tieBreaker domain.TieBreaker) map[uuid.UUID]int {
+	values := make(map[uuid.UUID]int, len(group))
+
+	switch tieBreaker {
+	case domain.TieBreakerGoalDifference:
+		for _, c := range group {
+			values[c.TeamID] = c.GoalsFor - c.GoalsAgainst
+		}
+	case domain.TieBreakerGoalsFor:
+		for _, c := range group {
+			values[c.TeamID] = c.GoalsFor
+		}
+	case domain.TieBreakerAwayGoalsFor:
+		for _, c := range group {
+			values[c.TeamID] = c.Away.GoalsFor
+		}
+	case domain.TieBreakerFairPlay:
+		for _, c := range group {
+			values[c.TeamID] = -fairPlayPoints[c.TeamID]
+		}
+	case domain.TieBreakerHeadToHeadPoints, domain.TieBreakerHeadToHeadGoalDifference:
+		records := headToHead(group, matches)
+		for _, c := range group {
+			if tieBreaker == domain.TieBreakerHeadToHeadPoints {
+				values[c.TeamID] = records[c.TeamID].points
+			} else {
+				values[c.TeamID] = records[c.TeamID].goalDifference
+			}
+		}
+	}
+
+	return values
+}
+
+// headToHead adds up the league matches between the tied teams. Play-off
+// matches stored in the league season do not count.
+func headToHead(group []domain.Classification, matches []domain.SeasonMatch) map[uuid.UUID]headToHeadRecord {
+	tied := make(map[uuid.UUID]bool, len(group))
+	for _, c := range group {
+		tied[c.TeamID] = true
+	}
+
+	records := make(map[uuid.UUID]headToHeadRecord, len(group))
+	for _, m := range matches {
+		if m.HomeResult == nil || m.AwayResult == nil || m.CupTieID != nil {
+			continue
+		}
+		if !tied[m.HomeTeamID] || !tied[m.AwayTeamID] {
+			continue
+		}
+
+		home := records[m.HomeTeamID]
+		away := records[m.AwayTeamID]
+
+		homeGoals, awayGoals := *m.HomeResult, *m.AwayResult
+		home.goalDifference += homeGoals - awayGoals
+		away.goalDifference += awayGoals - homeGoals
+
+		switch {
+		case homeGoals > awayGoals:
+			home.points += 3
+		case homeGoals < awayGoals:
+			away.points += 3
+		default:
+			home.points++
+			away.points++
+		}
+
+		records[m.HomeTeamID] = home
+		records[m.AwayTeamID] = away
+	}
+
+	return records
+}
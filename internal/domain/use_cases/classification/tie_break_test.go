@@ -0,0 +1,93 @@
+package classification_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/classification"
+	"github.com/stretchr/testify/assert"
+)
+
+func newResult(home, away uuid.UUID, homeGoals, awayGoals int) domain.SeasonMatch {
+	return domain.SeasonMatch{
+		ID:         uuid.New(),
+		HomeTeamID: home,
+		AwayTeamID: away,
+		HomeResult: &homeGoals,
+		AwayResult: &awayGoals,
+	}
+}
+
+func TestRankClassificationAppliesTieBreakers(t *testing.T) {
+	alpha := domain.Classification{TeamID: uuid.New(), TeamName: "Alpha", Points: 6, GoalsFor: 8, GoalsAgainst: 2}
+	beta := domain.Classification{TeamID: uuid.New(), TeamName: "Beta", Points: 6, GoalsFor: 5, GoalsAgainst: 3}
+	gamma := domain.Classification{TeamID: uuid.New(), TeamName: "Gamma", Points: 0, GoalsFor: 1, GoalsAgainst: 9}
+
+	matches := []domain.SeasonMatch{
+		newResult(beta.TeamID, alpha.TeamID, 1, 0),
+		newResult(alpha.TeamID, gamma.TeamID, 8, 1),
+		newResult(beta.TeamID, gamma.TeamID, 4, 0),
+	}
+	teams := []domain.Classification{gamma, beta, alpha}
+
+	ranked := classification.RankClassification(teams, matches, nil, []domain.TieBreaker{
+		domain.TieBreakerGoalDifference,
+		domain.TieBreakerHeadToHeadPoints,
+	})
+	assert.Equal(t, []string{"Alpha", "Beta", "Gamma"}, teamNames(ranked))
+	assert.Equal(t, []int{1, 2, 3}, positions(ranked))
+
+	ranked = classification.RankClassification(teams, matches, nil, []domain.TieBreaker{
+		domain.TieBreakerHeadToHeadPoints,
+		domain.TieBreakerGoalDifference,
+	})
+	assert.Equal(t, []string{"Beta", "Alpha", "Gamma"}, teamNames(ranked))
+	assert.Equal(t, []int{1, 2, 3}, positions(ranked))
+}
+
+func TestRankClassificationIgnoresPlayoffMatches(t *testing.T) {
+	alpha := domain.Classification{TeamID: uuid.New(), TeamName: "Alpha", Points: 3}
+	beta := domain.Classification{TeamID: uuid.New(), TeamName: "Beta", Points: 3}
+
+	playoff := newResult(beta.TeamID, alpha.TeamID, 3, 0)
+	tieID := uuid.New()
+	playoff.CupTieID = &tieID
+	matches := []domain.SeasonMatch{newResult(alpha.TeamID, beta.TeamID, 1, 0), playoff}
+
+	ranked := classification.RankClassification([]domain.Classification{beta, alpha}, matches, nil, []domain.TieBreaker{
+		domain.TieBreakerHeadToHeadGoalDifference,
+	})
+	assert.Equal(t, []string{"Alpha", "Beta"}, teamNames(ranked))
+}
+
+func TestRankClassificationIsDeterministicOnFullTie(t *testing.T) {
+	alpha := domain.Classification{TeamID: uuid.New(), TeamName: "Alpha"}
+	beta := domain.Classification{TeamID: uuid.New(), TeamName: "Beta"}
+
+	fairPlay := map[uuid.UUID]int{alpha.TeamID: 4, beta.TeamID: 4}
+
+	ranked := classification.RankClassification([]domain.Classification{beta, alpha}, nil, fairPlay, nil)
+	assert.Equal(t, []string{"Alpha", "Beta"}, teamNames(ranked))
+	assert.Equal(t, []int{1, 2}, positions(ranked))
+
+	fairPlay[alpha.TeamID] = 7
+	ranked = classification.RankClassification([]domain.Classification{alpha, beta}, nil, fairPlay, nil)
+	assert.Equal(t, []string{"Beta", "Alpha"}, teamNames(ranked))
+}
+
+func teamNames(classification []domain.Classification) []string {
+	names := make([]string, 0, len(classification))
+	for _, c := range classification {
+		names = append(names, c.TeamName)
+	}
+	return names
+}
+
+func positions(classification []domain.Classification) []int {
+	result := make([]int, 0, len(classification))
+	for _, c := range classification {
+		result = append(result, c.Position)
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package cup
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// AdvanceRound draws the next round once every tie of the last round is
+// decided. Running it again before that round is played does nothing.
+func (a AppService) AdvanceRound(seasonID uuid.UUID) error {
+	ties, err := a.repo.GetCupTies(seasonID)
+	if err != nil {
+		return err
+	}
+	if len(ties) == 0 {
+		return nil
+	}
+
+	lastRound := ties[len(ties)-1].Round
+	var current []domain.CupTie
+	for _, tie := range ties {
+		if tie.Round == lastRound {
+			current = append(current, tie)
+		}
+	}
+
+	var lastMatchDate time.Time
+	for _, tie := range current {
+		if tie.WinnerTeamID == nil {
+			return nil
+		}
+		for _, m := range tie.Matches {
+			if m.MatchDate.After(lastMatchDate) {
+				lastMatchDate = m.MatchDate
+			}
+		}
+	}
+
+	if len(current) == 1 {
+		log.Printf("Cup season %s finished, winner %s", seasonID, *current[0].WinnerTeamID)
+		return nil
+	}
+
+	tournament, err := a.tournamentRepo.GetTournamentBySeasonID(seasonID)
+	if err != nil {
+		return err
+	}
+
+	next, err := DrawNextRound(current, lastMatchDate.AddDate(0, 0, daysBetweenLegs), tournament.TwoLegged)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Cup season %s advancing to round %d with %d ties", seasonID, lastRound+1, len(next))
+	return a.repo.PostCupRound(next)
+}
+
+// AdvanceRounds draws the next round of every open cup whose last round is
+// decided, catching up on draws that failed after the result of the deciding
+// match was saved. A failed draw does not stop the others.
+func (a AppService) AdvanceRounds() error {
+	seasonIDs, err := a.repo.GetOpenCupSeasons()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, seasonID := range seasonIDs {
+		if err := a.AdvanceRound(seasonID); err != nil {
+			errs = append(errs, fmt.Errorf("season %s: %w", seasonID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,29 @@
+package cup
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type Repository interface {
+	GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error)
+	GetCupTie(tieID uuid.UUID) (domain.CupTie, error)
+	PostCupRound(ties []domain.CupTie) error
+	GetOpenCupSeasons() ([]uuid.UUID, error)
+}
+
+type TournamentRepository interface {
+	GetTournamentBySeasonID(seasonID uuid.UUID) (domain.Tournament, error)
+}
+
+func NewApp(repository Repository, tournamentRepository TournamentRepository) AppService {
+	return AppService{
+		repo:           repository,
+		tournamentRepo: tournamentRepository,
+	}
+}
+
+type AppService struct {
+	repo           Repository
+	tournamentRepo TournamentRepository
+}
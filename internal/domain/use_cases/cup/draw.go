@@ -0,0 +1,101 @@
+package cup
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const daysBetweenLegs = 7
+
+func DrawFirstRound(seasonID uuid.UUID, teamIDs []uuid.UUID, startDate time.Time, twoLegged bool) ([]domain.CupTie, error) {
+	if len(teamIDs) < 2 {
+		return nil, errors.New("a cup needs at least two teams")
+	}
+
+	bracketSize := 1
+	for bracketSize < len(teamIDs) {
+		bracketSize *= 2
+	}
+	numberOfTies := bracketSize / 2
+	byes := bracketSize - len(teamIDs)
+	playedTies := numberOfTies - byes
+
+	ties := make([]domain.CupTie, 0, numberOfTies)
+	next := 0
+	for position := 0; position < numberOfTies; position++ {
+		giveBye := byes > 0 && (position%2 == 1 || playedTies == 0)
+		if giveBye {
+			winner := teamIDs[next]
+			ties = append(ties, domain.CupTie{
+				SeasonID:     seasonID,
+				Round:        1,
+				Position:     position,
+				HomeTeamID:   teamIDs[next],
+				WinnerTeamID: &winner,
+			})
+			next++
+			byes--
+			continue
+		}
+
+		ties = append(ties, newTie(seasonID, 1, position, teamIDs[next], teamIDs[next+1], startDate, twoLegged && numberOfTies > 1))
+		next += 2
+		playedTies--
+	}
+
+	return ties, nil
+}
+
+func DrawNextRound(previous []domain.CupTie, startDate time.Time, twoLegged bool) ([]domain.CupTie, error) {
+	if len(previous) < 2 || len(previous)%2 != 0 {
+		return nil, errors.New("previous round must have an even number of ties")
+	}
+
+	numberOfTies := len(previous) / 2
+	ties := make([]domain.CupTie, 0, numberOfTies)
+	for position := 0; position < numberOfTies; position++ {
+		first, second := previous[2*position], previous[2*position+1]
+		if first.WinnerTeamID == nil || second.WinnerTeamID == nil {
+			return nil, errors.New("previous round is not finished")
+		}
+		ties = append(ties, newTie(first.SeasonID, first.Round+1, position, *first.WinnerTeamID, *second.WinnerTeamID, startDate, twoLegged && numberOfTies > 1))
+	}
+
+	return ties, nil
+}
+
+func newTie(seasonID uuid.UUID, round, position int, homeTeamID, awayTeamID uuid.UUID, matchDate time.Time, twoLegged bool) domain.CupTie {
+	away := awayTeamID
+	tie := domain.CupTie{
+		SeasonID:   seasonID,
+		Round:      round,
+		Position:   position,
+		HomeTeamID: homeTeamID,
+		AwayTeamID: &away,
+		TwoLegged:  twoLegged,
+		Matches: []domain.SeasonMatch{
+			{
+				SeasonID:   seasonID,
+				HomeTeamID: homeTeamID,
+				AwayTeamID: awayTeamID,
+				MatchDate:  matchDate,
+				Leg:        1,
+			},
+		},
+	}
+
+	if twoLegged {
+		tie.Matches = append(tie.Matches, domain.SeasonMatch{
+			SeasonID:   seasonID,
+			HomeTeamID: awayTeamID,
+			AwayTeamID: homeTeamID,
+			MatchDate:  matchDate.AddDate(0, 0, daysBetweenLegs),
+			Leg:        2,
+		})
+	}
+
+	return tie
+}
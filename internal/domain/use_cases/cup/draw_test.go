@@ -0,0 +1,84 @@
+package cup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/cup"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTeamIDs(n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	return ids
+}
+
+func TestDrawFirstRoundGivesByesForNonPowerOfTwoFields(t *testing.T) {
+	seasonID := uuid.New()
+	teamIDs := newTeamIDs(5)
+	startDate := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	ties, err := cup.DrawFirstRound(seasonID, teamIDs, startDate, false)
+
+	assert.NoError(t, err)
+	assert.Len(t, ties, 4)
+
+	seen := make(map[uuid.UUID]bool)
+	var byes, matches int
+	for i, tie := range ties {
+		assert.Equal(t, 1, tie.Round)
+		assert.Equal(t, i, tie.Position)
+		seen[tie.HomeTeamID] = true
+		if tie.AwayTeamID == nil {
+			byes++
+			assert.Equal(t, tie.HomeTeamID, *tie.WinnerTeamID)
+			assert.Empty(t, tie.Matches)
+			continue
+		}
+		seen[*tie.AwayTeamID] = true
+		matches += len(tie.Matches)
+	}
+	assert.Equal(t, 3, byes)
+	assert.Equal(t, 1, matches)
+	assert.Len(t, seen, 5)
+}
+
+func TestDrawNextRoundPairsWinnersAndKeepsFinalSingleLeg(t *testing.T) {
+	seasonID := uuid.New()
+	startDate := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	firstRound, err := cup.DrawFirstRound(seasonID, newTeamIDs(4), startDate, true)
+	assert.NoError(t, err)
+	assert.Len(t, firstRound, 2)
+	for i := range firstRound {
+		assert.True(t, firstRound[i].TwoLegged)
+		assert.Len(t, firstRound[i].Matches, 2)
+		assert.Equal(t, firstRound[i].Matches[0].HomeTeamID, firstRound[i].Matches[1].AwayTeamID)
+		winner := *firstRound[i].AwayTeamID
+		firstRound[i].WinnerTeamID = &winner
+	}
+
+	final, err := cup.DrawNextRound(firstRound, startDate.AddDate(0, 0, 14), true)
+
+	assert.NoError(t, err)
+	assert.Len(t, final, 1)
+	assert.Equal(t, 2, final[0].Round)
+	assert.False(t, final[0].TwoLegged)
+	assert.Len(t, final[0].Matches, 1)
+	assert.Equal(t, *firstRound[0].WinnerTeamID, final[0].HomeTeamID)
+	assert.Equal(t, *firstRound[1].WinnerTeamID, *final[0].AwayTeamID)
+}
+
+func TestDrawNextRoundRejectsUnfinishedRound(t *testing.T) {
+	startDate := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	firstRound, err := cup.DrawFirstRound(uuid.New(), newTeamIDs(4), startDate, false)
+	assert.NoError(t, err)
+
+	_, err = cup.DrawNextRound(firstRound, startDate, false)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,28 @@
+package cup
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (a AppService) GenerateFirstRound(seasonID uuid.UUID, teamIDs []uuid.UUID, startDate time.Time) error {
+	tournament, err := a.tournamentRepo.GetTournamentBySeasonID(seasonID)
+	if err != nil {
+		return err
+	}
+
+	shuffled := make([]uuid.UUID, len(teamIDs))
+	copy(shuffled, teamIDs)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	ties, err := DrawFirstRound(seasonID, shuffled, startDate, tournament.TwoLegged)
+	if err != nil {
+		return err
+	}
+
+	return a.repo.PostCupRound(ties)
+}
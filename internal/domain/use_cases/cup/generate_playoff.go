@@ -0,0 +1,29 @@
+package cup
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (a AppService) GeneratePlayoff(seasonID uuid.UUID, rankedTeamIDs []uuid.UUID, startDate time.Time) error {
+	tournament, err := a.tournamentRepo.GetTournamentBySeasonID(seasonID)
+	if err != nil {
+		return err
+	}
+
+	seeded := make([]uuid.UUID, 0, len(rankedTeamIDs))
+	for i, j := 0, len(rankedTeamIDs)-1; i <= j; i, j = i+1, j-1 {
+		seeded = append(seeded, rankedTeamIDs[i])
+		if i != j {
+			seeded = append(seeded, rankedTeamIDs[j])
+		}
+	}
+
+	ties, err := DrawFirstRound(seasonID, seeded, startDate, tournament.TwoLegged)
+	if err != nil {
+		return err
+	}
+
+	return a.repo.PostCupRound(ties)
+}
@@ -0,0 +1,14 @@
+package cup
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (a AppService) GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error) {
+	return a.repo.GetCupTies(seasonID)
+}
+
+func (a AppService) GetCupTie(tieID uuid.UUID) (domain.CupTie, error) {
+	return a.repo.GetCupTie(tieID)
+}
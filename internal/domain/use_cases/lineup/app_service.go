@@ -0,0 +1,22 @@
+package lineup
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type Repository interface {
+	GetTeamFormation(teamID uuid.UUID) (string, error)
+	GetSquad(teamID uuid.UUID) ([]domain.Player, error)
+	SaveLineup(teamID uuid.UUID, starters, bench []uuid.UUID) error
+}
+
+func NewApp(repository Repository) AppService {
+	return AppService{
+		repo: repository,
+	}
+}
+
+type AppService struct {
+	repo Repository
+}
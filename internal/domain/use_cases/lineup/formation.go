@@ -0,0 +1,30 @@
+package lineup
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// FormationShape returns how many starters each position needs for one of
+// domain.Formations, always with a single goalkeeper.
+func FormationShape(formation string) (map[string]int, error) {
+	if !slices.Contains(domain.Formations, formation) {
+		return nil, fmt.Errorf("%w: unsupported formation %q", domain.ErrInvalidLineup, formation)
+	}
+
+	lines := strings.Split(formation, "-")
+	shape := map[string]int{domain.PositionGoalkeeper: 1}
+	for i, position := range []string{domain.PositionDefender, domain.PositionMidfielder, domain.PositionForward} {
+		n, err := strconv.Atoi(lines[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: unsupported formation %q", domain.ErrInvalidLineup, formation)
+		}
+		shape[position] = n
+	}
+
+	return shape, nil
+}
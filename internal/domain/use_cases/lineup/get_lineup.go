@@ -0,0 +1,35 @@
+package lineup
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (a AppService) GetLineup(teamID uuid.UUID) (domain.Lineup, error) {
+	formation, err := a.repo.GetTeamFormation(teamID)
+	if err != nil {
+		return domain.Lineup{}, fmt.Errorf("error retrieving formation: %w", err)
+	}
+
+	squad, err := a.repo.GetSquad(teamID)
+	if err != nil {
+		return domain.Lineup{}, fmt.Errorf("error retrieving squad: %w", err)
+	}
+
+	lineup := domain.Lineup{
+		TeamID:    teamID,
+		Formation: formation,
+	}
+	for _, p := range squad {
+		switch {
+		case p.Lined:
+			lineup.Starters = append(lineup.Starters, p)
+		case p.Benched:
+			lineup.Bench = append(lineup.Bench, p)
+		}
+	}
+
+	return lineup, nil
+}
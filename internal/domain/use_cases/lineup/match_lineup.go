@@ -0,0 +1,35 @@
+package lineup
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// MatchLineup returns the team's manual lineup when it is valid and otherwise
+// picks the best eleven from the whole squad.
+func (a AppService) MatchLineup(team domain.Team, formation string) (domain.Lineup, error) {
+	selected := append(append([]domain.Player{}, team.Players...), team.Bench...)
+	lineup, err := ValidateLineup(team.Id, formation, selected, playerIDs(team.Players), playerIDs(team.Bench))
+	if err == nil {
+		return lineup, nil
+	}
+	log.Printf("Team %s has no valid lineup (%v), picking the best eleven", team.Name, err)
+
+	squad, err := a.repo.GetSquad(team.Id)
+	if err != nil {
+		return domain.Lineup{}, fmt.Errorf("error retrieving squad: %w", err)
+	}
+
+	return PickBestLineup(team.Id, formation, squad)
+}
+
+func playerIDs(players []domain.Player) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(players))
+	for _, p := range players {
+		ids = append(ids, p.PlayerId)
+	}
+	return ids
+}
@@ -0,0 +1,143 @@
+package lineup
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const (
+	DefaultFormation = "4-4-2"
+	minMatchFitness  = 40
+)
+
+type attributeWeights struct {
+	technique float64
+	mental    float64
+	physique  float64
+}
+
+var positionWeights = map[string]attributeWeights{
+	domain.PositionGoalkeeper: {technique: 0.2, mental: 0.5, physique: 0.3},
+	domain.PositionDefender:   {technique: 0.2, mental: 0.35, physique: 0.45},
+	domain.PositionMidfielder: {technique: 0.45, mental: 0.4, physique: 0.15},
+	domain.PositionForward:    {technique: 0.5, mental: 0.2, physique: 0.3},
+}
+
+var lineupPositions = []string{
+	domain.PositionGoalkeeper,
+	domain.PositionDefender,
+	domain.PositionMidfielder,
+	domain.PositionForward,
+}
+
+// PositionScore rates how well a player fits a position from technique,
+// mental and physique.
+func PositionScore(p domain.Player, position string) float64 {
+	w := positionWeights[position]
+	return float64(p.Technique)*w.technique + float64(p.Mental)*w.mental + float64(p.Physique)*w.physique
+}
+
+// PickBestLineup selects the strongest available eleven for the formation and
+// fills the bench with the best of the rest. Injured and suspended players are
+// never picked and unfit players only when nobody else can play the position.
+func PickBestLineup(teamID uuid.UUID, formation string, squad []domain.Player) (domain.Lineup, error) {
+	shape, err := FormationShape(formation)
+	if err != nil {
+		log.Printf("Team %s: %v, using %s", teamID, err, DefaultFormation)
+		formation = DefaultFormation
+		shape, _ = FormationShape(formation)
+	}
+
+	var available []domain.Player
+	for _, p := range squad {
+		if p.InjuryDays == 0 && p.SuspendedMatches == 0 {
+			available = append(available, p)
+		}
+	}
+	if len(available) < domain.StartingPlayers {
+		return domain.Lineup{}, fmt.Errorf("%w: only %d available players", domain.ErrLineupNotSet, len(available))
+	}
+
+	lineup := domain.Lineup{
+		TeamID:    teamID,
+		Formation: formation,
+	}
+	picked := make(map[uuid.UUID]bool, len(available))
+	missing := make(map[string]int, len(shape))
+
+	for _, position := range lineupPositions {
+		candidates := rankCandidates(available, picked, position, true)
+		n := min(shape[position], len(candidates))
+		for _, p := range candidates[:n] {
+			picked[p.PlayerId] = true
+			lineup.Starters = append(lineup.Starters, p)
+		}
+		missing[position] = shape[position] - n
+	}
+
+	for _, position := range lineupPositions {
+		candidates := rankCandidates(available, picked, position, false)
+		for _, p := range candidates[:missing[position]] {
+			picked[p.PlayerId] = true
+			lineup.Starters = append(lineup.Starters, p)
+		}
+	}
+
+	if backups := rankCandidates(available, picked, domain.PositionGoalkeeper, true); len(backups) > 0 {
+		picked[backups[0].PlayerId] = true
+		lineup.Bench = append(lineup.Bench, backups[0])
+	}
+	for _, p := range rankCandidates(available, picked, "", false) {
+		if len(lineup.Bench) == domain.MaxBenchPlayers {
+			break
+		}
+		lineup.Bench = append(lineup.Bench, p)
+	}
+
+	for i := range lineup.Starters {
+		lineup.Starters[i].Lined, lineup.Starters[i].Benched = true, false
+	}
+	for i := range lineup.Bench {
+		lineup.Bench[i].Lined, lineup.Bench[i].Benched = false, true
+	}
+
+	return lineup, nil
+}
+
+// rankCandidates orders the players not yet picked, fit players first and then
+// by score. With samePosition only players of that position are considered; an
+// empty position ranks everyone at their own position.
+func rankCandidates(players []domain.Player, picked map[uuid.UUID]bool, position string, samePosition bool) []domain.Player {
+	var candidates []domain.Player
+	for _, p := range players {
+		if picked[p.PlayerId] || (samePosition && p.Position != position) {
+			continue
+		}
+		candidates = append(candidates, p)
+	}
+
+	score := func(p domain.Player) float64 {
+		if position == "" {
+			return PositionScore(p, p.Position)
+		}
+		return PositionScore(p, position)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		fitI, fitJ := candidates[i].Fitness >= minMatchFitness, candidates[j].Fitness >= minMatchFitness
+		if fitI != fitJ {
+			return fitI
+		}
+		scoreI, scoreJ := score(candidates[i]), score(candidates[j])
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+		return candidates[i].PlayerId.String() < candidates[j].PlayerId.String()
+	})
+
+	return candidates
+}
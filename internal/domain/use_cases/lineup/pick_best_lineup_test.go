@@ -0,0 +1,88 @@
+package lineup_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/lineup"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPlayer(position string, quality, fitness, injuryDays int) domain.Player {
+	return domain.Player{
+		PlayerId:   uuid.New(),
+		Position:   position,
+		Technique:  quality,
+		Mental:     quality,
+		Physique:   quality,
+		Fitness:    fitness,
+		InjuryDays: injuryDays,
+	}
+}
+
+func TestPickBestLineupSkipsInjuredAndUnfitPlayers(t *testing.T) {
+	injuredStar := newPlayer(domain.PositionForward, 95, 90, 10)
+	tiredStar := newPlayer(domain.PositionForward, 90, 20, 0)
+	squad := []domain.Player{
+		newPlayer(domain.PositionGoalkeeper, 80, 90, 0),
+		newPlayer(domain.PositionGoalkeeper, 60, 90, 0),
+		injuredStar,
+		tiredStar,
+	}
+	for i := 0; i < 5; i++ {
+		squad = append(squad, newPlayer(domain.PositionDefender, 70+i, 90, 0))
+		squad = append(squad, newPlayer(domain.PositionMidfielder, 70+i, 90, 0))
+	}
+	for i := 0; i < 3; i++ {
+		squad = append(squad, newPlayer(domain.PositionForward, 60+i, 90, 0))
+	}
+
+	result, err := lineup.PickBestLineup(uuid.New(), "4-3-3", squad)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Starters, domain.StartingPlayers)
+	positions := map[string]int{}
+	for _, p := range result.Starters {
+		positions[p.Position]++
+		assert.NotEqual(t, injuredStar.PlayerId, p.PlayerId)
+		assert.NotEqual(t, tiredStar.PlayerId, p.PlayerId)
+	}
+	assert.Equal(t, map[string]int{
+		domain.PositionGoalkeeper: 1,
+		domain.PositionDefender:   4,
+		domain.PositionMidfielder: 3,
+		domain.PositionForward:    3,
+	}, positions)
+	assert.Equal(t, 80, result.Starters[0].Technique)
+	assert.Equal(t, domain.PositionGoalkeeper, result.Bench[0].Position)
+	for _, p := range result.Bench {
+		assert.NotEqual(t, injuredStar.PlayerId, p.PlayerId)
+	}
+}
+
+func TestPickBestLineupFillsShortPositionsAndFallsBackToDefaultFormation(t *testing.T) {
+	squad := []domain.Player{newPlayer(domain.PositionGoalkeeper, 70, 90, 0)}
+	for i := 0; i < 10; i++ {
+		squad = append(squad, newPlayer(domain.PositionMidfielder, 70, 90, 0))
+	}
+
+	result, err := lineup.PickBestLineup(uuid.New(), "", squad)
+
+	assert.NoError(t, err)
+	assert.Equal(t, lineup.DefaultFormation, result.Formation)
+	assert.Len(t, result.Starters, domain.StartingPlayers)
+	assert.Empty(t, result.Bench)
+}
+
+func TestPickBestLineupNeedsElevenAvailablePlayers(t *testing.T) {
+	squad := []domain.Player{newPlayer(domain.PositionGoalkeeper, 70, 90, 0)}
+	for i := 0; i < 10; i++ {
+		squad = append(squad, newPlayer(domain.PositionDefender, 70, 90, i%2))
+	}
+
+	_, err := lineup.PickBestLineup(uuid.New(), "4-4-2", squad)
+
+	assert.True(t, errors.Is(err, domain.ErrLineupNotSet))
+}
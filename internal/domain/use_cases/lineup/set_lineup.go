@@ -0,0 +1,23 @@
+package lineup
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (a AppService) SetLineup(teamID uuid.UUID, starterIDs, benchIDs []uuid.UUID) (domain.Lineup, error) {
+	lineup, err := a.CheckLineup(teamID, starterIDs, benchIDs)
+	if err != nil {
+		return domain.Lineup{}, err
+	}
+
+	if err := a.repo.SaveLineup(teamID, starterIDs, benchIDs); err != nil {
+		return domain.Lineup{}, fmt.Errorf("error saving lineup: %w", err)
+	}
+	log.Printf("Saved lineup for team %s: %d starters, %d substitutes", teamID, len(lineup.Starters), len(lineup.Bench))
+
+	return lineup, nil
+}
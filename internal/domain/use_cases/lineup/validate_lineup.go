@@ -0,0 +1,96 @@
+package lineup
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// ValidateLineup checks a starting eleven and bench picked from the squad
+// against the formation and returns the resulting lineup.
+func ValidateLineup(teamID uuid.UUID, formation string, squad []domain.Player, starterIDs, benchIDs []uuid.UUID) (domain.Lineup, error) {
+	shape, err := FormationShape(formation)
+	if err != nil {
+		return domain.Lineup{}, err
+	}
+
+	if len(starterIDs) != domain.StartingPlayers {
+		return domain.Lineup{}, fmt.Errorf("%w: %d starters, need %d", domain.ErrInvalidLineup, len(starterIDs), domain.StartingPlayers)
+	}
+	if len(benchIDs) > domain.MaxBenchPlayers {
+		return domain.Lineup{}, fmt.Errorf("%w: %d substitutes, at most %d allowed", domain.ErrInvalidLineup, len(benchIDs), domain.MaxBenchPlayers)
+	}
+
+	players := make(map[uuid.UUID]domain.Player, len(squad))
+	for _, p := range squad {
+		players[p.PlayerId] = p
+	}
+
+	selected := make(map[uuid.UUID]bool, len(starterIDs)+len(benchIDs))
+	pick := func(playerID uuid.UUID) (domain.Player, error) {
+		p, ok := players[playerID]
+		if !ok {
+			return domain.Player{}, fmt.Errorf("%w: player %s is not in the squad", domain.ErrInvalidLineup, playerID)
+		}
+		if selected[playerID] {
+			return domain.Player{}, fmt.Errorf("%w: player %s selected twice", domain.ErrInvalidLineup, playerID)
+		}
+		if p.InjuryDays > 0 {
+			return domain.Player{}, fmt.Errorf("%w: %s %s is injured for %d days", domain.ErrInvalidLineup, p.FirstName, p.LastName, p.InjuryDays)
+		}
+		if p.SuspendedMatches > 0 {
+			return domain.Player{}, fmt.Errorf("%w: %s %s is suspended for %d matches", domain.ErrInvalidLineup, p.FirstName, p.LastName, p.SuspendedMatches)
+		}
+		selected[playerID] = true
+		return p, nil
+	}
+
+	lineup := domain.Lineup{
+		TeamID:    teamID,
+		Formation: formation,
+	}
+	positions := make(map[string]int, len(shape))
+	for _, playerID := range starterIDs {
+		p, err := pick(playerID)
+		if err != nil {
+			return domain.Lineup{}, err
+		}
+		p.Lined, p.Benched = true, false
+		positions[p.Position]++
+		lineup.Starters = append(lineup.Starters, p)
+	}
+	for _, playerID := range benchIDs {
+		p, err := pick(playerID)
+		if err != nil {
+			return domain.Lineup{}, err
+		}
+		p.Lined, p.Benched = false, true
+		lineup.Bench = append(lineup.Bench, p)
+	}
+
+	if positions[domain.PositionGoalkeeper] != 1 {
+		return domain.Lineup{}, fmt.Errorf("%w: %d goalkeepers, need exactly 1", domain.ErrInvalidLineup, positions[domain.PositionGoalkeeper])
+	}
+	for _, position := range []string{domain.PositionDefender, domain.PositionMidfielder, domain.PositionForward} {
+		if positions[position] != shape[position] {
+			return domain.Lineup{}, fmt.Errorf("%w: %d %ss, formation %s needs %d", domain.ErrInvalidLineup, positions[position], position, formation, shape[position])
+		}
+	}
+
+	return lineup, nil
+}
+
+func (a AppService) CheckLineup(teamID uuid.UUID, starterIDs, benchIDs []uuid.UUID) (domain.Lineup, error) {
+	formation, err := a.repo.GetTeamFormation(teamID)
+	if err != nil {
+		return domain.Lineup{}, fmt.Errorf("error retrieving formation: %w", err)
+	}
+
+	squad, err := a.repo.GetSquad(teamID)
+	if err != nil {
+		return domain.Lineup{}, fmt.Errorf("error retrieving squad: %w", err)
+	}
+
+	return ValidateLineup(teamID, formation, squad, starterIDs, benchIDs)
+}
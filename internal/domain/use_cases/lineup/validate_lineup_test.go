@@ -0,0 +1,97 @@
+package lineup_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/lineup"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSquad(positions map[string]int) []domain.Player {
+	var squad []domain.Player
+	for _, position := range []string{domain.PositionGoalkeeper, domain.PositionDefender, domain.PositionMidfielder, domain.PositionForward} {
+		for i := 0; i < positions[position]; i++ {
+			squad = append(squad, domain.Player{PlayerId: uuid.New(), Position: position, Technique: 50, Mental: 50, Physique: 50})
+		}
+	}
+	return squad
+}
+
+func ids(players []domain.Player) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(players))
+	for _, p := range players {
+		result = append(result, p.PlayerId)
+	}
+	return result
+}
+
+func TestFormationShape(t *testing.T) {
+	for _, formation := range domain.Formations {
+		shape, err := lineup.FormationShape(formation)
+		if assert.NoError(t, err, formation) {
+			total := 0
+			for _, n := range shape {
+				total += n
+			}
+			assert.Equal(t, domain.StartingPlayers, total, formation)
+		}
+	}
+
+	for _, formation := range []string{"2-4-4", "1-5-4", "4-2-3-1", ""} {
+		_, err := lineup.FormationShape(formation)
+		assert.True(t, errors.Is(err, domain.ErrInvalidLineup), "%q: got %v", formation, err)
+	}
+}
+
+func TestValidateLineupAcceptsFormationShape(t *testing.T) {
+	squad := newSquad(map[string]int{domain.PositionGoalkeeper: 2, domain.PositionDefender: 5, domain.PositionMidfielder: 5, domain.PositionForward: 3})
+	starters := append(append(append([]domain.Player{squad[0]}, squad[2:6]...), squad[7:10]...), squad[12:15]...)
+	bench := []domain.Player{squad[1], squad[6], squad[10]}
+
+	result, err := lineup.ValidateLineup(uuid.New(), "4-3-3", squad, ids(starters), ids(bench))
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Starters, domain.StartingPlayers)
+	assert.Len(t, result.Bench, 3)
+	assert.True(t, result.Starters[0].Lined)
+	assert.True(t, result.Bench[0].Benched)
+}
+
+func TestValidateLineupRejectsInvalidSelections(t *testing.T) {
+	squad := newSquad(map[string]int{domain.PositionGoalkeeper: 2, domain.PositionDefender: 5, domain.PositionMidfielder: 5, domain.PositionForward: 3})
+	valid := append(append(append([]domain.Player{squad[0]}, squad[2:6]...), squad[7:10]...), squad[12:15]...)
+
+	twoGoalkeepers := append([]domain.Player{squad[1]}, valid[:10]...)
+	wrongShape := append(append([]domain.Player{squad[0]}, squad[2:7]...), squad[7:12]...)
+	injuredSquad := append([]domain.Player{}, squad...)
+	injuredSquad[2].InjuryDays = 5
+	suspendedSquad := append([]domain.Player{}, squad...)
+	suspendedSquad[7].SuspendedMatches = 1
+
+	tests := map[string]struct {
+		squad     []domain.Player
+		formation string
+		starters  []uuid.UUID
+		bench     []uuid.UUID
+	}{
+		"two goalkeepers":     {squad, "4-3-3", ids(twoGoalkeepers), nil},
+		"shape mismatch":      {squad, "4-3-3", ids(wrongShape), nil},
+		"injured starter":     {injuredSquad, "4-3-3", ids(valid), nil},
+		"suspended starter":   {suspendedSquad, "4-3-3", ids(valid), nil},
+		"too few starters":    {squad, "4-3-3", ids(valid[:10]), nil},
+		"unknown formation":   {squad, "4-2-3-1", ids(valid), nil},
+		"player not in squad": {squad, "4-3-3", append(ids(valid[1:]), uuid.New()), nil},
+		"starter on bench":    {squad, "4-3-3", ids(valid), ids(valid[:1])},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := lineup.ValidateLineup(uuid.New(), tc.formation, tc.squad, tc.starters, tc.bench)
+
+			assert.True(t, errors.Is(err, domain.ErrInvalidLineup), "got %v", err)
+		})
+	}
+}
@@ -12,32 +12,50 @@ type MatchRepository interface {
 	PostMatchEvent(event domain.MatchEventInfo) error
 	PostMatches(matches []domain.SeasonMatch) error
 	GetPendingMatches(timestamp time.Time) ([]domain.SeasonMatch, error)
-	UpdateMatch(seasonMatch domain.SeasonMatch) error
+	SaveMatchResult(record domain.MatchRecord) error
 	GetMatchByID(matchID uuid.UUID) (domain.SeasonMatch, error)
 	GetMatchEvents(matchID uuid.UUID) ([]domain.MatchEventInfo, error)
 	GetSeasonMatches(seasonID uuid.UUID) ([]domain.SeasonMatch, error)
-}
-
-type ClassificationRepository interface {
-	UpdateClassification(domain.Classification) error
+	GetMatchVenue(matchID uuid.UUID) (domain.Venue, error)
+	GetMatchTeamStats(matchID uuid.UUID) ([]domain.MatchTeamStats, error)
+	GetMatchRatings(matchID uuid.UUID) ([]domain.PlayerRating, error)
+	GetSeasonRatings(seasonID uuid.UUID) ([]domain.SeasonRating, error)
 }
 
 type TeamRepository interface {
 	GetTeamByID(teamID uuid.UUID) (domain.Team, error)
 }
 
-func NewApp(matchRepo MatchRepository, classificationRepo ClassificationRepository, teamRepo TeamRepository) AppService {
+type CupApp interface {
+	GetCupTie(tieID uuid.UUID) (domain.CupTie, error)
+	GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error)
+	AdvanceRound(seasonID uuid.UUID) error
+}
+
+type LineupApp interface {
+	MatchLineup(team domain.Team, formation string) (domain.Lineup, error)
+}
+
+func NewApp(matchRepo MatchRepository, teamRepo TeamRepository, cupApp CupApp, lineupApp LineupApp) AppService {
 	return AppService{
-		matchRepo:          matchRepo,
-		classificationRepo: classificationRepo,
-		teamRepo:           teamRepo,
-		simulator:          NewSimulator(),
+		matchRepo: matchRepo,
+		teamRepo:  teamRepo,
+		cupApp:    cupApp,
+		lineupApp: lineupApp,
 	}
 }
 
 type AppService struct {
-	matchRepo          MatchRepository
-	classificationRepo ClassificationRepository
-	teamRepo           TeamRepository
-	simulator          Simulator
+	matchRepo MatchRepository
+	teamRepo  TeamRepository
+	cupApp    CupApp
+	lineupApp LineupApp
+	engine    Engine
+}
+
+// WithEngine sets the engine matches are played with, the event budget one
+// by default.
+func (a AppService) WithEngine(engine Engine) AppService {
+	a.engine = engine
+	return a
 }
@@ -3,10 +3,9 @@ package match
 import (
 	"log"
 	"math/rand"
-	"time"
 )
 
-func CalculateBallPossession(homeTotalTechnique, awayTotalTechnique, homeTotalQuality, awayTotalQuality, allQuality int, homePossessionResultOfStrategy, awayPossessionResultOfStrategy float64) (int, int, error) {
+func CalculateBallPossession(rng *rand.Rand, homeTotalTechnique, awayTotalTechnique, homeTotalQuality, awayTotalQuality, allQuality int, homePossessionResultOfStrategy, awayPossessionResultOfStrategy float64) (int, int, error) {
 	percentageHomeQuality := (float64(homeTotalQuality) / float64(allQuality)) * 100
 
 	switch {
@@ -43,8 +42,7 @@ func CalculateBallPossession(homeTotalTechnique, awayTotalTechnique, homeTotalQu
 	}
 	log.Println("team possession after strategy", percentageHomeQuality)
 
-	rand.Seed(time.Now().UnixNano())
-	randomFactor := 0.8 + rand.Float64()*(1.2-0.8)
+	randomFactor := 0.8 + rng.Float64()*(1.2-0.8)
 	log.Println("randomFactor is", randomFactor)
 	percentageHomeQualityWithRandomFactor := percentageHomeQuality * randomFactor
 	log.Println("team possession after randomFactor", percentageHomeQualityWithRandomFactor)
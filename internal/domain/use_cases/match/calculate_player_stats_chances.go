@@ -3,7 +3,6 @@ package match
 import (
 	"log"
 	"math/rand"
-	"time"
 
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 
@@ -53,24 +52,24 @@ func ModifyChancesForBuildUpPlay(strategy domain.Strategy, forwardChances, midfi
 	return forwardChances, midfieldChances, defenderChances
 }
 
-func DistributeChancesToPlayers(lineup []domain.Player, forwardChances, midfieldChances, defenderChances, totalChances int) map[uuid.UUID]int {
+func DistributeChancesToPlayers(rng *rand.Rand, lineup []domain.Player, forwardChances, midfieldChances, defenderChances, totalChances int) map[uuid.UUID]int {
 	chancesByPlayer := make(map[uuid.UUID]int)
 
 	forwards := filterPlayersByPosition(lineup, domain.PositionForward)
 	midfielders := filterPlayersByPosition(lineup, domain.PositionMidfielder)
 	defenders := filterPlayersByPosition(lineup, domain.PositionDefender)
 
-	forwardChancesByPlayer := DistributeChances(forwards, forwardChances)
+	forwardChancesByPlayer := DistributeChances(rng, forwards, forwardChances)
 	for k, v := range forwardChancesByPlayer {
 		chancesByPlayer[k] = v
 	}
 
-	midfieldChancesByPlayer := DistributeChances(midfielders, midfieldChances)
+	midfieldChancesByPlayer := DistributeChances(rng, midfielders, midfieldChances)
 	for k, v := range midfieldChancesByPlayer {
 		chancesByPlayer[k] = v
 	}
 
-	defenderChancesByPlayer := DistributeChances(defenders, defenderChances)
+	defenderChancesByPlayer := DistributeChances(rng, defenders, defenderChances)
 	for k, v := range defenderChancesByPlayer {
 		chancesByPlayer[k] = v
 	}
@@ -78,14 +77,12 @@ func DistributeChancesToPlayers(lineup []domain.Player, forwardChances, midfield
 	return chancesByPlayer
 }
 
-func DistributeChances(players []domain.Player, totalChances int) map[uuid.UUID]int {
+func DistributeChances(rng *rand.Rand, players []domain.Player, totalChances int) map[uuid.UUID]int {
 	chancesByPlayer := make(map[uuid.UUID]int)
 	if len(players) == 0 {
 		return chancesByPlayer
 	}
 
-	rand.Seed(time.Now().UnixNano())
-
 	totalWeight := 0.0
 	for _, player := range players {
 		playerWeight := calculatePlayerWeight(player)
@@ -97,7 +94,7 @@ func DistributeChances(players []domain.Player, totalChances int) map[uuid.UUID]
 
 		playerChances := int((playerWeight / totalWeight) * float64(totalChances))
 
-		randomFactor := rand.Float64() * 0.45
+		randomFactor := rng.Float64() * 0.45
 		playerChances = int(float64(playerChances) * (1 + randomFactor))
 
 		chancesByPlayer[player.PlayerId] = playerChances
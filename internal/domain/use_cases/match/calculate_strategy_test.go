@@ -0,0 +1,17 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEveryFormationCanBePlayed(t *testing.T) {
+	team := newTestTeam("Home", 80)
+	for _, formation := range domain.Formations {
+		_, err := match.CalculatePossessionChancesByFormation(team.Players, formation)
+		assert.NoError(t, err, formation)
+	}
+}
@@ -0,0 +1,203 @@
+package match
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type sentOffPlayer struct {
+	player domain.Player
+	minute int
+}
+
+// booking picks the player of the side that committed a foul and lets the
+// referee decide on a card for them.
+func booking(rng *rand.Rand, side *matchSide, minute int) (*domain.Player, []domain.EventResult) {
+	fouler := GetRandomDefender(rng, side.team.Players)
+	if fouler == nil {
+		fouler = GetRandomPlayerExcludingGoalkeeper(rng, side.team.Players)
+	}
+	if fouler == nil {
+		return nil, nil
+	}
+
+	card, booked := YellowOrRedCard(rng, *fouler)
+	if !booked {
+		return fouler, nil
+	}
+	if side.cardLeniency > 0 && rng.Float64() < side.cardLeniency {
+		log.Printf("the referee lets %s %s off at home", fouler.FirstName, fouler.LastName)
+		return fouler, nil
+	}
+	return fouler, side.book(minute, *fouler, card)
+}
+
+// book records a card. A second yellow becomes a red and a red card sends the
+// player off for the rest of the match.
+func (s *matchSide) book(minute int, player domain.Player, card domain.Card) []domain.EventResult {
+	booking := domain.Booking{
+		PlayerID: player.PlayerId,
+		TeamID:   s.team.Id,
+		Minute:   minute,
+		Card:     card,
+	}
+
+	var events []domain.EventResult
+	if card == domain.CardYellow {
+		s.yellowCards[player.PlayerId]++
+		events = append(events, s.cardEvent(minute, player, EventTypeYellowCard, fmt.Sprintf("The referee gives %s %s a yellow card", player.FirstName, player.LastName)))
+		if s.yellowCards[player.PlayerId] < 2 {
+			s.bookings = append(s.bookings, booking)
+			return events
+		}
+		booking.Card = domain.CardRed
+		booking.SecondYellow = true
+		events = append(events, s.cardEvent(minute, player, EventTypeRedCard, fmt.Sprintf("Second yellow for %s %s, it is a red card", player.FirstName, player.LastName)))
+	} else {
+		events = append(events, s.cardEvent(minute, player, EventTypeRedCard, fmt.Sprintf("The referee gives %s %s a straight red card", player.FirstName, player.LastName)))
+	}
+
+	s.bookings = append(s.bookings, booking)
+	return append(events, s.sendOff(minute, player)...)
+}
+
+func (s *matchSide) cardEvent(minute int, player domain.Player, eventType EventType, sentence string) domain.EventResult {
+	log.Printf("%s (team %s, minute %d)", sentence, s.team.Name, minute)
+	return domain.EventResult{
+		Event:       sentence,
+		Minute:      minute,
+		EventType:   string(eventType),
+		TeamId:      s.team.Id,
+		TeamName:    s.team.Name,
+		EventActors: domain.EventActors{PlayerID: playerID(&player)},
+	}
+}
+
+// sendOff removes the player without replacement. When the goalkeeper is sent
+// off an outfield player makes way for the substitute keeper or, without one,
+// goes in goal.
+func (s *matchSide) sendOff(minute int, player domain.Player) []domain.EventResult {
+	s.team.Players = replacePlayer(s.team.Players, player.PlayerId, nil)
+	s.sentOff = append(s.sentOff, sentOffPlayer{player: player, minute: minute})
+	s.goOff(player.PlayerId, minute)
+	log.Printf("team %s plays with %d players", s.team.Name, len(s.team.Players))
+	return s.coverPosition(minute, player.Position)
+}
+
+// coverPosition mans a position the last player there has just left: the goal
+// with a keeper from the bench or an outfield player, and an outfield line
+// with the weakest player of the fullest other line.
+func (s *matchSide) coverPosition(minute int, position string) []domain.EventResult {
+	if countPosition(s.team.Players, position) > 0 {
+		return nil
+	}
+	if position != domain.PositionGoalkeeper {
+		s.fillLine(position)
+		return nil
+	}
+	out := s.emergencyKeeper()
+	if out == nil {
+		return nil
+	}
+	if s.canSubstitute(minute) {
+		if in := bestOfPosition(s.team.Bench, domain.PositionGoalkeeper); in != nil {
+			return []domain.EventResult{s.substitute(minute, *out, *in)}
+		}
+	}
+
+	keeper := *out
+	keeper.Position = domain.PositionGoalkeeper
+	s.team.Players = replacePlayer(s.team.Players, out.PlayerId, &keeper)
+	log.Printf("%s %s goes in goal for team %s", keeper.FirstName, keeper.LastName, s.team.Name)
+	return nil
+}
+
+func (s *matchSide) fillLine(position string) {
+	from, most := "", 1
+	for _, line := range []string{domain.PositionForward, domain.PositionMidfielder, domain.PositionDefender} {
+		if count := countPosition(s.team.Players, line); line != position && count > most {
+			from, most = line, count
+		}
+	}
+	if from == "" {
+		return
+	}
+
+	cover := *weakest(s.team.Players, from)
+	cover.Position = position
+	s.team.Players = replacePlayer(s.team.Players, cover.PlayerId, &cover)
+	log.Printf("%s %s drops into the %s line for team %s", cover.FirstName, cover.LastName, position, s.team.Name)
+}
+
+func (s *matchSide) emergencyKeeper() *domain.Player {
+	for _, position := range []string{domain.PositionForward, domain.PositionMidfielder, domain.PositionDefender} {
+		if p := weakest(s.team.Players, position); p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// playedStats totals the starters' attributes, leaving out sent-off players
+// for the part of the period they missed.
+func (s *matchSide) playedStats(starters []domain.Player, period Period) (technique, mental, physique int) {
+	technique, mental, physique = totalStats(starters)
+	end := period.FirstMinute + period.Minutes
+	for _, sent := range s.sentOff {
+		if sent.minute >= end {
+			continue
+		}
+		missed := end - max(sent.minute, period.FirstMinute)
+		technique -= sent.player.Technique * missed / period.Minutes
+		mental -= sent.player.Mental * missed / period.Minutes
+		physique -= sent.player.Physique * missed / period.Minutes
+	}
+	return technique, mental, physique
+}
+
+// Bookings returns every card shown in the match in chronological order.
+func (s Simulator) Bookings() []domain.Booking {
+	var bookings []domain.Booking
+	for _, side := range s.sides {
+		bookings = append(bookings, side.bookings...)
+	}
+	sort.SliceStable(bookings, func(i, j int) bool {
+		if bookings[i].Minute != bookings[j].Minute {
+			return bookings[i].Minute < bookings[j].Minute
+		}
+		return bookings[i].PlayerID.String() < bookings[j].PlayerID.String()
+	})
+	return bookings
+}
+
+// PlayerDisciplines turns the cards of a match into what they add to each
+// player's record. Yellow cards of a sent-off player do not count towards a
+// ban; the red card brings its own.
+func PlayerDisciplines(bookings []domain.Booking) []domain.PlayerDiscipline {
+	var disciplines []domain.PlayerDiscipline
+	index := make(map[uuid.UUID]int)
+	for _, booking := range bookings {
+		i, ok := index[booking.PlayerID]
+		if !ok {
+			i = len(disciplines)
+			index[booking.PlayerID] = i
+			disciplines = append(disciplines, domain.PlayerDiscipline{PlayerID: booking.PlayerID})
+		}
+
+		switch booking.Card {
+		case domain.CardYellow:
+			if disciplines[i].SuspendedMatches == 0 {
+				disciplines[i].YellowCards++
+			}
+		case domain.CardRed:
+			disciplines[i].YellowCards = 0
+			disciplines[i].SuspendedMatches = domain.RedCardSuspension
+		}
+	}
+	return disciplines
+}
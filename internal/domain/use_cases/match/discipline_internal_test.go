@@ -0,0 +1,107 @@
+package match
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func eventTypes(events []domain.EventResult) []string {
+	types := make([]string, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.EventType)
+	}
+	return types
+}
+
+func TestSecondYellowSendsThePlayerOff(t *testing.T) {
+	side := testSide()
+	side.startPeriod(RegularTime)
+	player := side.team.Players[3]
+
+	events := side.book(20, player, domain.CardYellow)
+	assert.Equal(t, []string{string(EventTypeYellowCard)}, eventTypes(events))
+	assert.True(t, onPitch(side, player.PlayerId))
+
+	events = side.book(55, player, domain.CardYellow)
+	assert.Equal(t, []string{string(EventTypeYellowCard), string(EventTypeRedCard)}, eventTypes(events))
+	assert.False(t, onPitch(side, player.PlayerId))
+	assert.Len(t, side.team.Players, 10, "no replacement for a sent-off player")
+
+	assert.Equal(t, []domain.Booking{
+		{PlayerID: player.PlayerId, TeamID: side.team.Id, Minute: 20, Card: domain.CardYellow},
+		{PlayerID: player.PlayerId, TeamID: side.team.Id, Minute: 55, Card: domain.CardRed, SecondYellow: true},
+	}, side.bookings)
+	for _, appearance := range side.appearances() {
+		if appearance.PlayerID == player.PlayerId {
+			assert.Equal(t, 55, appearance.Minutes)
+		}
+	}
+}
+
+func TestSentOffGoalkeeperIsReplaced(t *testing.T) {
+	side := testSide()
+	keeper, substitute, forward := side.team.Players[0], side.team.Bench[0], side.team.Players[8]
+
+	events := side.book(30, keeper, domain.CardRed)
+
+	assert.Equal(t, []string{string(EventTypeRedCard), string(EventTypeSubstitution)}, eventTypes(events))
+	assert.Equal(t, &substitute.PlayerId, events[1].PlayerID)
+	assert.Equal(t, &forward.PlayerId, events[1].SecondaryPlayerID, "the weakest forward makes way")
+	assert.Len(t, side.team.Players, 10)
+	assert.Equal(t, substitute.PlayerId, bestOfPosition(side.team.Players, domain.PositionGoalkeeper).PlayerId)
+}
+
+func TestSentOffGoalkeeperWithoutSubstitute(t *testing.T) {
+	side := testSide()
+	side.team.Bench = nil
+	keeper, forward := side.team.Players[0], side.team.Players[8]
+
+	events := side.book(30, keeper, domain.CardRed)
+
+	assert.Equal(t, []string{string(EventTypeRedCard)}, eventTypes(events))
+	assert.Len(t, side.team.Players, 10)
+	assert.Equal(t, forward.PlayerId, bestOfPosition(side.team.Players, domain.PositionGoalkeeper).PlayerId, "the weakest forward goes in goal")
+}
+
+func TestPlayedStatsLeaveOutSentOffPlayers(t *testing.T) {
+	side := testSide()
+	starters := side.team.Players
+	technique, _, _ := totalStats(starters)
+	player := starters[5]
+
+	side.book(45, player, domain.CardRed)
+
+	played, _, _ := side.playedStats(starters, RegularTime)
+	assert.Equal(t, technique-player.Technique/2, played)
+}
+
+func TestCornerKickAgainstASideWithoutDefenders(t *testing.T) {
+	side, attackers := testSide(), testSide()
+	for _, defender := range playersOfPosition(side.team.Players, domain.PositionDefender) {
+		side.team.Players = replacePlayer(side.team.Players, defender.PlayerId, nil)
+	}
+	for i := range attackers.team.Players {
+		attackers.team.Players[i].Technique = 99
+	}
+
+	assert.NotPanics(t, func() {
+		_, err := CornerKick(rand.New(rand.NewSource(1)), attackers.team, side.team)
+		assert.Error(t, err)
+	})
+}
+
+func TestSentOffLastDefenderIsCovered(t *testing.T) {
+	side := testSide()
+	side.team.Players = side.team.Players[:2]
+	side.team.Players = append(side.team.Players, testPlayer(domain.PositionMidfielder, 70), testPlayer(domain.PositionForward, 70), testPlayer(domain.PositionForward, 71))
+	defender, forward := side.team.Players[1], side.team.Players[3]
+
+	events := side.book(40, defender, domain.CardRed)
+
+	assert.Equal(t, []string{string(EventTypeRedCard)}, eventTypes(events))
+	assert.Len(t, side.team.Players, 4)
+	assert.Equal(t, forward.PlayerId, bestOfPosition(side.team.Players, domain.PositionDefender).PlayerId, "the fullest line gives up its weakest player")
+}
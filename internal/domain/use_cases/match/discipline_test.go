@@ -0,0 +1,28 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayerDisciplines(t *testing.T) {
+	booked, sentOff, straightRed := uuid.New(), uuid.New(), uuid.New()
+	bookings := []domain.Booking{
+		{PlayerID: booked, Minute: 10, Card: domain.CardYellow},
+		{PlayerID: sentOff, Minute: 20, Card: domain.CardYellow},
+		{PlayerID: sentOff, Minute: 55, Card: domain.CardRed, SecondYellow: true},
+		{PlayerID: straightRed, Minute: 70, Card: domain.CardRed},
+	}
+
+	disciplines := match.PlayerDisciplines(bookings)
+
+	assert.Equal(t, []domain.PlayerDiscipline{
+		{PlayerID: booked, YellowCards: 1},
+		{PlayerID: sentOff, SuspendedMatches: domain.RedCardSuspension},
+		{PlayerID: straightRed, SuspendedMatches: domain.RedCardSuspension},
+	}, disciplines)
+}
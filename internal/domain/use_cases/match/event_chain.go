@@ -0,0 +1,81 @@
+package match
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// eventLink is one event of a chain. rival is set when the rival of the team
+// that started the chain plays it.
+type eventLink struct {
+	name    string
+	outcome domain.EventOutcome
+	rival   bool
+}
+
+// eventChain lists an event and the follow-ups it led to in the order they
+// happened.
+func eventChain(name string, outcome domain.EventOutcome, rival bool) []eventLink {
+	links := []eventLink{{name: name, outcome: outcome, rival: rival}}
+	for _, next := range outcome.FollowUps {
+		links = append(links, eventChain(next.EventType, next.EventOutcome, rival != next.Rival)...)
+	}
+	return links
+}
+
+// chainTally is what the chains of a period left for one team.
+type chainTally struct {
+	results []domain.EventResult
+	chances int
+	goals   int
+	xg      float64
+}
+
+// playChain records every link of a chain at the minute it was played. Goals
+// from any link count, while a chain is one chance at most for each team.
+// Fouls name the player who committed them, who may get booked.
+func playChain(rng *rand.Rand, name string, outcome domain.EventOutcome, minute int, lineup, rival *matchSide, lineupTally, rivalTally *chainTally) {
+	var lineupChance, rivalChance bool
+	for _, link := range eventChain(name, outcome, false) {
+		side, other := lineup, rival
+		sideTally, otherTally := lineupTally, rivalTally
+		sideChance, otherChance := &lineupChance, &rivalChance
+		if link.rival {
+			side, other = rival, lineup
+			sideTally, otherTally = rivalTally, lineupTally
+			sideChance, otherChance = &rivalChance, &lineupChance
+		}
+
+		sideTally.results = append(sideTally.results, domain.EventResult{
+			Event:       link.outcome.Sentence + fmt.Sprintf(" for the team %s", side.team.Name),
+			Minute:      minute,
+			EventType:   link.name,
+			TeamId:      side.team.Id,
+			TeamName:    side.team.Name,
+			XG:          link.outcome.XG,
+			EventActors: link.outcome.EventActors,
+		})
+		sideTally.xg += link.outcome.XG
+		sideTally.goals += link.outcome.LineupGoals
+		otherTally.goals += link.outcome.RivalGoals
+		*sideChance = *sideChance || link.outcome.LineupChances > 0
+		*otherChance = *otherChance || link.outcome.RivalChances > 0
+
+		if link.name == string(EventTypeFoul) {
+			fouler, cards := booking(rng, other, minute)
+			if fouler != nil {
+				sideTally.results[len(sideTally.results)-1].OpponentPlayerID = playerID(fouler)
+			}
+			otherTally.results = append(otherTally.results, cards...)
+		}
+	}
+
+	if lineupChance {
+		lineupTally.chances++
+	}
+	if rivalChance {
+		rivalTally.chances++
+	}
+}
@@ -0,0 +1,95 @@
+package match_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func teamPlayers(team domain.Team) map[uuid.UUID]bool {
+	players := map[uuid.UUID]bool{}
+	for _, player := range append(team.Players, team.Bench...) {
+		players[player.PlayerId] = true
+	}
+	return players
+}
+
+func TestShotAttributesShooterAndPasser(t *testing.T) {
+	t.Run("goal", func(t *testing.T) {
+		home, away := newTestTeam("Home", 99), newTestTeam("Away", 1)
+		passer := home.Players[5]
+
+		outcome, err := match.Shot(rand.New(rand.NewSource(1)), home, away, &passer)
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.OutcomeGoal, outcome.Outcome)
+		assert.Equal(t, 1, outcome.LineupGoals)
+		if assert.NotNil(t, outcome.PlayerID) {
+			assert.True(t, teamPlayers(home)[*outcome.PlayerID], "the scorer plays for the lineup")
+		}
+		assert.Equal(t, &passer.PlayerId, outcome.SecondaryPlayerID)
+		assert.Equal(t, &away.Players[0].PlayerId, outcome.OpponentPlayerID, "the goalkeeper is beaten")
+	})
+
+	t.Run("blocked", func(t *testing.T) {
+		home, away := newTestTeam("Home", 1), newTestTeam("Away", 99)
+
+		outcome, err := match.Shot(rand.New(rand.NewSource(1)), home, away, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, domain.OutcomeBlocked, outcome.Outcome)
+		assert.Zero(t, outcome.LineupGoals)
+		assert.NotNil(t, outcome.PlayerID)
+		assert.Nil(t, outcome.SecondaryPlayerID)
+		if assert.NotNil(t, outcome.OpponentPlayerID) {
+			assert.True(t, teamPlayers(away)[*outcome.OpponentPlayerID], "a rival defender blocks the shot")
+			assert.NotEqual(t, away.Players[0].PlayerId, *outcome.OpponentPlayerID)
+		}
+	})
+}
+
+func TestKeyPassChainsTheFinish(t *testing.T) {
+	home, away := newTestTeam("Home", 85), newTestTeam("Away", 70)
+
+	var completed int
+	for seed := int64(1); seed <= 50; seed++ {
+		outcome, err := match.KeyPass(rand.New(rand.NewSource(seed)), home, away)
+		assert.NoError(t, err)
+
+		if outcome.Outcome != domain.OutcomeCompleted {
+			assert.Empty(t, outcome.FollowUps)
+			continue
+		}
+		completed++
+		if assert.Len(t, outcome.FollowUps, 1) {
+			finish := outcome.FollowUps[0]
+			assert.Contains(t, []string{string(match.EventTypeShot), string(match.EventTypePenaltyKick)}, finish.EventType)
+			assert.False(t, finish.Rival)
+			assert.Equal(t, finish.Outcome == domain.OutcomeGoal, finish.LineupGoals == 1)
+		}
+	}
+	assert.Greater(t, completed, 0)
+}
+
+func withoutPosition(players []domain.Player, position string) []domain.Player {
+	var kept []domain.Player
+	for _, player := range players {
+		if player.Position != position {
+			kept = append(kept, player)
+		}
+	}
+	return kept
+}
+
+func TestCornerKickWithoutDefenders(t *testing.T) {
+	home, away := newTestTeam("Home", 99), newTestTeam("Away", 80)
+	away.Players = withoutPosition(away.Players, domain.PositionDefender)
+
+	_, err := match.CornerKick(rand.New(rand.NewSource(1)), home, away)
+
+	assert.Error(t, err)
+}
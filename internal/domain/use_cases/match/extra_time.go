@@ -0,0 +1,141 @@
+package match
+
+import (
+	"errors"
+	"log"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const (
+	extraTimeMinutes   = 30
+	shootoutKicks      = 5
+	maxSuddenDeathKick = 20
+)
+
+func (s Simulator) PlayExtraTime(m *domain.Match) (domain.MatchEventStats, []domain.EventResult, error) {
+	s.side(m.HomeMatchStrategy.StrategyTeam).ensureStrategy(m.HomeMatchStrategy)
+	s.side(m.AwayMatchStrategy.StrategyTeam).ensureStrategy(m.AwayMatchStrategy)
+	homeTeam := s.side(m.HomeMatchStrategy.StrategyTeam).team
+	awayTeam := s.side(m.AwayMatchStrategy.StrategyTeam).team
+
+	var stats domain.MatchEventStats
+	if s.engine == EngineMinuteByMinute {
+		stats, _, _ = s.playByMinute(m, ExtraTime)
+	} else {
+		var err error
+		stats, err = s.extraTimeEventBudget(m, homeTeam, awayTeam)
+		if err != nil {
+			return domain.MatchEventStats{}, nil, err
+		}
+	}
+
+	events := append(stats.HomeEvents, stats.AwayEvents...)
+	events = append(events, domain.EventResult{
+		Minute:    ExtraTime.FirstMinute + ExtraTime.Minutes,
+		AddedTime: stats.AddedTime[len(stats.AddedTime)-1],
+		EventType: string(EventTypeEndOfExtraTime),
+		Event:     "Final de la prórroga",
+		TeamId:    homeTeam.Id,
+	})
+	sortEvents(events)
+
+	return stats, events, nil
+}
+
+func (s Simulator) extraTimeEventBudget(m *domain.Match, homeTeam, awayTeam domain.Team) (domain.MatchEventStats, error) {
+	numberOfMatchEvents, err := CalculateNumberOfMatchEvents(s.rng, m.HomeMatchStrategy.GameTempo, m.AwayMatchStrategy.GameTempo)
+	if err != nil {
+		return domain.MatchEventStats{}, err
+	}
+	numberOfMatchEvents = numberOfMatchEvents * extraTimeMinutes / 90
+	if numberOfMatchEvents < 1 {
+		numberOfMatchEvents = 1
+	}
+
+	numberOfHomeEvents, numberOfAwayEvents, err := DistributeMatchEvents(s.rng, homeTeam, awayTeam, numberOfMatchEvents, 1, 1)
+	if err != nil {
+		return domain.MatchEventStats{}, err
+	}
+	numberOfHomeEvents, numberOfAwayEvents = shiftEventsHome(s.rng, numberOfHomeEvents, numberOfAwayEvents, m.HomeAdvantage)
+	log.Println("extra time events", numberOfHomeEvents, numberOfAwayEvents)
+
+	return s.GenerateEvents(homeTeam, awayTeam, numberOfHomeEvents, numberOfAwayEvents, ExtraTime), nil
+}
+
+func (s Simulator) PlayPenaltyShootout(m *domain.Match) (int, int, []domain.EventResult, error) {
+	homeTeam := s.side(m.HomeMatchStrategy.StrategyTeam).team
+	awayTeam := s.side(m.AwayMatchStrategy.StrategyTeam).team
+
+	var homeScore, awayScore int
+	var events []domain.EventResult
+
+	kick := func(lineup, rival domain.Team) (int, error) {
+		shooter := GetRandomPlayerExcludingGoalkeeper(s.rng, lineup.Players)
+		if shooter == nil {
+			return 0, errors.New("no outfield player left to take a penalty")
+		}
+		outcome, err := penaltyBy(s.rng, shooter, rival)
+		if err != nil {
+			return 0, err
+		}
+		events = append(events, domain.EventResult{
+			Minute:      90 + extraTimeMinutes,
+			EventType:   string(EventTypePenaltyShootout),
+			Event:       outcome.Sentence,
+			TeamId:      lineup.Id,
+			TeamName:    lineup.Name,
+			EventActors: outcome.EventActors,
+		})
+		return outcome.LineupGoals, nil
+	}
+
+	for round := 0; round < shootoutKicks; round++ {
+		goals, err := kick(homeTeam, awayTeam)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		homeScore += goals
+		if decided(homeScore, awayScore, shootoutKicks-round-1, shootoutKicks-round) {
+			break
+		}
+
+		goals, err = kick(awayTeam, homeTeam)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		awayScore += goals
+		if decided(homeScore, awayScore, shootoutKicks-round-1, shootoutKicks-round-1) {
+			break
+		}
+	}
+
+	for round := 0; homeScore == awayScore; round++ {
+		if round == maxSuddenDeathKick {
+			if s.rng.Intn(2) == 0 {
+				homeScore++
+			} else {
+				awayScore++
+			}
+			break
+		}
+
+		homeGoals, err := kick(homeTeam, awayTeam)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		awayGoals, err := kick(awayTeam, homeTeam)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		homeScore += homeGoals
+		awayScore += awayGoals
+	}
+
+	log.Printf("penalty shootout finished %d-%d", homeScore, awayScore)
+	return homeScore, awayScore, events, nil
+}
+
+func decided(homeScore, awayScore, homeKicksLeft, awayKicksLeft int) bool {
+	return homeScore+homeKicksLeft < awayScore || awayScore+awayKicksLeft < homeScore
+}
@@ -0,0 +1,51 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatorExtraTimeEventsHappenAfterNinetyMinutes(t *testing.T) {
+	m := newTestMatch()
+
+	_, events, err := match.NewSimulator(7).PlayExtraTime(m)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, events)
+	for _, event := range events {
+		assert.GreaterOrEqual(t, event.Minute, 90)
+		assert.LessOrEqual(t, event.Minute, 120)
+	}
+	assert.Equal(t, string(match.EventTypeEndOfExtraTime), events[len(events)-1].EventType)
+}
+
+func TestSimulatorPenaltyShootoutAlwaysHasWinner(t *testing.T) {
+	m := newTestMatch()
+
+	for seed := int64(1); seed <= 50; seed++ {
+		homeScore, awayScore, events, err := match.NewSimulator(seed).PlayPenaltyShootout(m)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, homeScore, awayScore, "seed %d", seed)
+		assert.NotEmpty(t, events)
+	}
+}
+
+func TestSimulatorPenaltyShootoutWithoutForwards(t *testing.T) {
+	m := newTestMatch()
+	away := &m.AwayMatchStrategy.StrategyTeam
+	away.Players = withoutPosition(away.Players, domain.PositionForward)
+
+	homeScore, awayScore, events, err := match.NewSimulator(1).PlayPenaltyShootout(m)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, homeScore, awayScore)
+	for _, event := range events {
+		if event.TeamId == away.Id {
+			assert.NotNil(t, event.PlayerID, "any outfield player takes a kick")
+		}
+	}
+}
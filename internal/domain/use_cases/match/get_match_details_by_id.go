@@ -29,6 +29,9 @@ func (a *AppService) GetMatchDetailsByID(matchID uuid.UUID) (*httpMatch.MatchRes
 	}
 
 	var events []domain.MatchEventInfo
+	var homeStats, awayStats *httpMatch.TeamStats
+	var ratings []httpMatch.PlayerRating
+	var manOfTheMatch *httpMatch.PlayerRating
 	if match.HomeResult != nil && match.AwayResult != nil {
 		events, err = a.matchRepo.GetMatchEvents(matchID)
 		if err != nil {
@@ -36,14 +39,56 @@ func (a *AppService) GetMatchDetailsByID(matchID uuid.UUID) (*httpMatch.MatchRes
 			return nil, err
 		}
 		log.Printf("Number of events: %d", len(events))
+
+		teamStats, err := a.matchRepo.GetMatchTeamStats(matchID)
+		if err != nil {
+			log.Printf("Error getting match team stats: %v", err)
+			return nil, err
+		}
+		for _, stats := range teamStats {
+			switch stats.TeamID {
+			case match.HomeTeamID:
+				homeStats = httpTeamStats(stats.TeamStats)
+			case match.AwayTeamID:
+				awayStats = httpTeamStats(stats.TeamStats)
+			}
+		}
+
+		playerRatings, err := a.matchRepo.GetMatchRatings(matchID)
+		if err != nil {
+			log.Printf("Error getting player ratings: %v", err)
+			return nil, err
+		}
+		ratings = make([]httpMatch.PlayerRating, len(playerRatings))
+		for i, rating := range playerRatings {
+			ratings[i] = httpMatch.PlayerRating{
+				PlayerID:      rating.PlayerID,
+				TeamID:        rating.TeamID,
+				Minutes:       rating.Minutes,
+				Rating:        rating.Rating,
+				ManOfTheMatch: rating.ManOfTheMatch,
+			}
+			if rating.ManOfTheMatch {
+				manOfTheMatch = &ratings[i]
+			}
+		}
 	}
 
 	httpEvents := make([]httpMatch.MatchEvent, len(events))
 	for i, e := range events {
 		httpEvents[i] = httpMatch.MatchEvent{
-			ID:        e.ID,
-			EventType: e.EventType,
-			Minute:    e.Minute,
+			ID:                e.ID,
+			TeamID:            e.TeamId,
+			EventType:         e.EventType,
+			Minute:            e.Minute,
+			AddedTime:         e.AddedTime,
+			MinuteLabel:       domain.MinuteLabel(e.Minute, e.AddedTime),
+			Description:       e.Description,
+			PlayerID:          e.PlayerID,
+			SecondaryPlayerID: e.SecondaryPlayerID,
+			OpponentPlayerID:  e.OpponentPlayerID,
+			Outcome:           string(e.Outcome),
+			XG:                e.XG,
 		}
 	}
 
@@ -58,8 +103,35 @@ func (a *AppService) GetMatchDetailsByID(matchID uuid.UUID) (*httpMatch.MatchRes
 			ID:   match.AwayTeamID,
 			Name: awayTeam.Name,
 		},
-		HomeResult: match.HomeResult,
-		AwayResult: match.AwayResult,
-		Events:     httpEvents,
+		HomeResult:    match.HomeResult,
+		AwayResult:    match.AwayResult,
+		HomeHalfTime:  match.HomeHalfTime,
+		AwayHalfTime:  match.AwayHalfTime,
+		HomeXG:        match.HomeXG,
+		AwayXG:        match.AwayXG,
+		HomeStats:     homeStats,
+		AwayStats:     awayStats,
+		ManOfTheMatch: manOfTheMatch,
+		Ratings:       ratings,
+		Seed:          match.Seed,
+		Events:        httpEvents,
 	}, nil
 }
+
+func httpTeamStats(stats domain.TeamStats) *httpMatch.TeamStats {
+	return &httpMatch.TeamStats{
+		BallPossession:  stats.BallPossession,
+		ScoringChances:  stats.ScoringChances,
+		Shots:           stats.Shots,
+		ShotsOnTarget:   stats.ShotsOnTarget,
+		Corners:         stats.Corners,
+		Fouls:           stats.Fouls,
+		YellowCards:     stats.YellowCards,
+		RedCards:        stats.RedCards,
+		Offsides:        stats.Offsides,
+		Saves:           stats.Saves,
+		Passes:          stats.Passes,
+		PassesCompleted: stats.PassesCompleted,
+		PassSuccess:     stats.PassSuccess,
+	}
+}
@@ -0,0 +1,17 @@
+package match
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (a AppService) GetSeasonRatings(seasonID uuid.UUID) ([]domain.SeasonRating, error) {
+	ratings, err := a.matchRepo.GetSeasonRatings(seasonID)
+	if err != nil {
+		log.Printf("Error getting season ratings: %v", err)
+		return nil, err
+	}
+	return ratings, nil
+}
@@ -0,0 +1,49 @@
+package match
+
+import (
+	"math"
+	"math/rand"
+)
+
+const (
+	// homePossessionBonus is the possession, in percentage points, a standard
+	// home advantage (1.0) adds to the home side.
+	homePossessionBonus = 4
+	// homeEventShift is the share of the away side's events the home side
+	// takes over with a standard home advantage.
+	homeEventShift = 0.12
+	// homeCardLeniency is the share of home bookings the referee lets go
+	// with a standard home advantage.
+	homeCardLeniency = 0.2
+)
+
+// shiftEventsHome hands some of the away side's events to the home side,
+// more of them the stronger the home advantage.
+func shiftEventsHome(rng *rand.Rand, homeEvents, awayEvents int, homeAdvantage float64) (int, int) {
+	if homeAdvantage <= 0 {
+		return homeEvents, awayEvents
+	}
+	share := math.Min(homeEventShift*homeAdvantage, 1)
+	shifted := 0
+	for i := 0; i < awayEvents; i++ {
+		if rng.Float64() < share {
+			shifted++
+		}
+	}
+	return homeEvents + shifted, awayEvents - shifted
+}
+
+// shiftPossessionHome moves possession towards the home side, keeping both
+// sides within the usual 17-83 range.
+func shiftPossessionHome(homePossession, awayPossession int, homeAdvantage float64) (int, int) {
+	bonus := int(math.Round(homePossessionBonus * homeAdvantage))
+	bonus = min(bonus, 83-homePossession, awayPossession-17)
+	if bonus <= 0 {
+		return homePossession, awayPossession
+	}
+	return homePossession + bonus, awayPossession - bonus
+}
+
+func cardLeniency(homeAdvantage float64) float64 {
+	return math.Max(0, math.Min(homeCardLeniency*homeAdvantage, 1))
+}
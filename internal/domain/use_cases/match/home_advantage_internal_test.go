@@ -0,0 +1,58 @@
+package match
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShiftEventsHome(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	home, away := shiftEventsHome(rng, 10, 10, 0)
+	assert.Equal(t, []int{10, 10}, []int{home, away}, "no shift on neutral ground")
+
+	home, away = shiftEventsHome(rng, 10, 10, domain.DefaultHomeAdvantage)
+	assert.Equal(t, 20, home+away)
+	assert.GreaterOrEqual(t, home, 10)
+
+	home, away = shiftEventsHome(rng, 10, 10, 1/homeEventShift)
+	assert.Equal(t, []int{20, 0}, []int{home, away})
+}
+
+func TestShiftPossessionHome(t *testing.T) {
+	tests := map[string]struct {
+		home, away    int
+		homeAdvantage float64
+		want          []int
+	}{
+		"neutral ground":     {50, 50, 0, []int{50, 50}},
+		"standard advantage": {50, 50, 1, []int{54, 46}},
+		"double advantage":   {50, 50, 2, []int{58, 42}},
+		"capped":             {81, 19, 1, []int{83, 17}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			home, away := shiftPossessionHome(tc.home, tc.away, tc.homeAdvantage)
+			assert.Equal(t, tc.want, []int{home, away})
+		})
+	}
+}
+
+func TestHomeCardLeniency(t *testing.T) {
+	assert.Zero(t, cardLeniency(0))
+	assert.Equal(t, homeCardLeniency, cardLeniency(1))
+	assert.Equal(t, 1.0, cardLeniency(10))
+
+	side := testSide()
+	side.cardLeniency = 1
+	rng := rand.New(rand.NewSource(1))
+	for minute := 1; minute <= 90; minute++ {
+		fouler, cards := booking(rng, side, minute)
+		assert.NotNil(t, fouler)
+		assert.Empty(t, cards, "the referee lets every foul at home go")
+	}
+}
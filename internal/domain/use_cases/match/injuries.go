@@ -0,0 +1,53 @@
+package match
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func injuryDuringMatch(rng *rand.Rand, side *matchSide, minute int) []domain.EventResult {
+	sentence, injured, err := InjuryDuringMatch(rng, side.team)
+	if err != nil {
+		log.Printf("no injury for team %s: %v", side.team.Name, err)
+		return nil
+	}
+
+	severity, days := InjurySeverityAndDays(rng, *injured)
+	side.injuries = append(side.injuries, domain.Injury{
+		PlayerID: injured.PlayerId,
+		TeamID:   side.team.Id,
+		Minute:   minute,
+		Severity: severity,
+		Days:     days,
+	})
+	sentence += fmt.Sprintf(" It looks like a %s injury.", severity)
+
+	events := []domain.EventResult{{
+		Event:       sentence,
+		Minute:      minute,
+		EventType:   string(EventTypeInjuryDuringMatch),
+		TeamId:      side.team.Id,
+		TeamName:    side.team.Name,
+		EventActors: domain.EventActors{PlayerID: playerID(injured)},
+	}}
+	return append(events, side.forcedChange(minute, *injured)...)
+}
+
+// Injuries returns every injury suffered in the match in chronological order.
+func (s Simulator) Injuries() []domain.Injury {
+	var injuries []domain.Injury
+	for _, side := range s.sides {
+		injuries = append(injuries, side.injuries...)
+	}
+	sort.SliceStable(injuries, func(i, j int) bool {
+		if injuries[i].Minute != injuries[j].Minute {
+			return injuries[i].Minute < injuries[j].Minute
+		}
+		return injuries[i].PlayerID.String() < injuries[j].PlayerID.String()
+	})
+	return injuries
+}
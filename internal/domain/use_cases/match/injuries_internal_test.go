@@ -0,0 +1,40 @@
+package match
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInjuryDuringMatchForcesAChange(t *testing.T) {
+	side := testSide()
+
+	events := injuryDuringMatch(rand.New(rand.NewSource(1)), side, 30)
+
+	assert.Equal(t, []string{string(EventTypeInjuryDuringMatch), string(EventTypeSubstitution)}, eventTypes(events))
+	if assert.Len(t, side.injuries, 1) {
+		injury := side.injuries[0]
+		assert.Equal(t, &injury.PlayerID, events[0].PlayerID)
+		assert.Equal(t, &injury.PlayerID, events[1].SecondaryPlayerID)
+		assert.Equal(t, side.team.Id, injury.TeamID)
+		assert.Equal(t, 30, injury.Minute)
+		assert.Positive(t, injury.Days)
+		assert.False(t, onPitch(side, injury.PlayerID))
+	}
+	assert.Len(t, side.team.Players, 11)
+}
+
+func TestInjuriesAreInChronologicalOrder(t *testing.T) {
+	home, away := testSide(), testSide()
+	home.injuries = []domain.Injury{{PlayerID: uuid.New(), TeamID: home.team.Id, Minute: 70}}
+	away.injuries = []domain.Injury{{PlayerID: uuid.New(), TeamID: away.team.Id, Minute: 20}}
+	simulator := NewSimulator(1)
+	simulator.sides[home.team.Id], simulator.sides[away.team.Id] = home, away
+
+	injuries := simulator.Injuries()
+
+	assert.Equal(t, []domain.Injury{away.injuries[0], home.injuries[0]}, injuries)
+}
@@ -0,0 +1,29 @@
+package match_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInjurySeverityAndDays(t *testing.T) {
+	ranges := map[domain.InjurySeverity][2]int{
+		domain.InjuryMinor:    {2, 7},
+		domain.InjuryModerate: {8, 28},
+		domain.InjurySerious:  {29, 90},
+	}
+	rng := rand.New(rand.NewSource(1))
+	seen := map[domain.InjurySeverity]bool{}
+	for i := 0; i < 1000; i++ {
+		severity, days := match.InjurySeverityAndDays(rng, domain.Player{Physique: 60})
+		limits, ok := ranges[severity]
+		assert.True(t, ok, "unknown severity %s", severity)
+		assert.GreaterOrEqual(t, days, limits[0])
+		assert.LessOrEqual(t, days, limits[1])
+		seen[severity] = true
+	}
+	assert.Len(t, seen, len(ranges))
+}
@@ -0,0 +1,80 @@
+package match
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const (
+	winPoints  = 3
+	drawPoints = 1
+	losePoints = 0
+)
+
+func NewMatchClassifications(seasonID, homeTeamID, awayTeamID uuid.UUID, homeGoals, awayGoals int) (domain.Classification, domain.Classification) {
+	var homeClassification, awayClassification domain.Classification
+	var homeOutcome, awayOutcome string
+
+	switch {
+	case homeGoals > awayGoals:
+		homeOutcome = domain.ResultWin
+		awayOutcome = domain.ResultLoss
+
+	case homeGoals < awayGoals:
+		homeOutcome = domain.ResultLoss
+		awayOutcome = domain.ResultWin
+
+	default:
+		homeOutcome = domain.ResultDraw
+		awayOutcome = domain.ResultDraw
+	}
+
+	homeClassification.Home = newClassificationSplit(homeOutcome, homeGoals, awayGoals)
+	awayClassification.Away = newClassificationSplit(awayOutcome, awayGoals, homeGoals)
+
+	homeClassification = applySplit(homeClassification, homeClassification.Home)
+	awayClassification = applySplit(awayClassification, awayClassification.Away)
+
+	homeClassification.Form = homeOutcome
+	awayClassification.Form = awayOutcome
+
+	homeClassification.SeasonID = seasonID
+	awayClassification.SeasonID = seasonID
+
+	homeClassification.TeamID = homeTeamID
+	awayClassification.TeamID = awayTeamID
+
+	return homeClassification, awayClassification
+}
+
+func newClassificationSplit(outcome string, goalsFor, goalsAgainst int) domain.ClassificationSplit {
+	split := domain.ClassificationSplit{
+		Played:       1,
+		GoalsFor:     goalsFor,
+		GoalsAgainst: goalsAgainst,
+	}
+
+	switch outcome {
+	case domain.ResultWin:
+		split.Won = 1
+		split.Points = winPoints
+	case domain.ResultDraw:
+		split.Drawn = 1
+		split.Points = drawPoints
+	default:
+		split.Lost = 1
+		split.Points = losePoints
+	}
+	return split
+}
+
+func applySplit(classification domain.Classification, split domain.ClassificationSplit) domain.Classification {
+	classification.Played = split.Played
+	classification.Won = split.Won
+	classification.Drawn = split.Drawn
+	classification.Lost = split.Lost
+	classification.Points = split.Points
+	classification.GoalsFor = split.GoalsFor
+	classification.GoalsAgainst = split.GoalsAgainst
+	return classification
+}
@@ -0,0 +1,40 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMatchClassificationsTracksStandingsColumns(t *testing.T) {
+	seasonID := uuid.New()
+	homeTeamID := uuid.New()
+	awayTeamID := uuid.New()
+
+	home, away := match.NewMatchClassifications(seasonID, homeTeamID, awayTeamID, 2, 1)
+
+	assert.Equal(t, domain.Classification{
+		SeasonID:     seasonID,
+		TeamID:       homeTeamID,
+		Points:       3,
+		Played:       1,
+		Won:          1,
+		GoalsFor:     2,
+		GoalsAgainst: 1,
+		Form:         domain.ResultWin,
+		Home:         domain.ClassificationSplit{Played: 1, Won: 1, GoalsFor: 2, GoalsAgainst: 1, Points: 3},
+	}, home)
+	assert.Equal(t, domain.Classification{
+		SeasonID:     seasonID,
+		TeamID:       awayTeamID,
+		Played:       1,
+		Lost:         1,
+		GoalsFor:     1,
+		GoalsAgainst: 2,
+		Form:         domain.ResultLoss,
+		Away:         domain.ClassificationSplit{Played: 1, Lost: 1, GoalsFor: 1, GoalsAgainst: 2},
+	}, away)
+}
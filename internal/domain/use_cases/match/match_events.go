@@ -6,6 +6,7 @@ import (
 	"log"
 	"math/rand"
 
+	"github.com/google/uuid"
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
@@ -19,7 +20,8 @@ const (
 	EventTypeIndirectFreeKick   EventType = "INDIRECT_FREE_KICK"
 	EventTypeDribble            EventType = "DRIBBLE"
 	EventTypeFoul               EventType = "FOUL"
-	EventTypeYellowOrRedCard    EventType = "YELLOW_OR_RED_CARD"
+	EventTypeYellowCard         EventType = "YELLOW_CARD"
+	EventTypeRedCard            EventType = "RED_CARD"
 	EventTypeDirectFreeKick     EventType = "DIRECT_FREE_KICK"
 	EventTypeGreatScoringChance EventType = "GREAT_SCORING_CHANCE"
 	EventTypeCornerKick         EventType = "CORNER_KICK"
@@ -29,74 +31,78 @@ const (
 	EventTypeCounterAttack      EventType = "COUNTER_ATTACK"
 	EventTypeEndOfTheMatch      EventType = "END_OF_THE_MATCH"
 	EventTypeMatchBreak         EventType = "MATCH_BREAK"
+	EventTypeEndOfExtraTime     EventType = "END_OF_EXTRA_TIME"
+	EventTypePenaltyShootout    EventType = "PENALTY_SHOOTOUT"
+	EventTypeSubstitution       EventType = "SUBSTITUTION"
+	EventTypeTacticalChange     EventType = "TACTICAL_CHANGE"
 )
 
-func CalculateSuccessIndividualEvent(skill int) int {
+func CalculateSuccessIndividualEvent(rng *rand.Rand, skill int) int {
 	log.Printf("Evaluating success of individual event for skill level: %d", skill)
 
 	switch {
 	case skill < 8:
 		return 0
 	case skill >= 8 && skill < 14:
-		return ProbabilisticIncrement14()
+		return ProbabilisticIncrement14(rng)
 	case skill >= 14 && skill < 21:
-		return ProbabilisticIncrement20()
+		return ProbabilisticIncrement20(rng)
 	case skill >= 21 && skill < 30:
-		return ProbabilisticIncrement25()
+		return ProbabilisticIncrement25(rng)
 	case skill >= 30 && skill < 36:
-		return ProbabilisticIncrement33()
+		return ProbabilisticIncrement33(rng)
 	case skill >= 36 && skill < 44:
-		return ProbabilisticIncrement40()
+		return ProbabilisticIncrement40(rng)
 	case skill >= 44 && skill < 59:
-		return ProbabilisticIncrement50()
+		return ProbabilisticIncrement50(rng)
 	case skill >= 59 && skill < 68:
-		return ProbabilisticIncrement66()
+		return ProbabilisticIncrement66(rng)
 	case skill >= 68 && skill < 74:
-		return ProbabilisticIncrement75()
+		return ProbabilisticIncrement75(rng)
 	case skill >= 74 && skill < 92:
-		return ProbabilisticIncrement90()
+		return ProbabilisticIncrement90(rng)
 
 	default:
 		return 1
 	}
 }
 
-func CalculateSuccessConfrontation(atackerSkill, defenderSkill int) int {
+func CalculateSuccessConfrontation(rng *rand.Rand, atackerSkill, defenderSkill int) int {
 	log.Printf("Calculating confrontation success: Attacker skill = %d, Defender skill = %d", atackerSkill, defenderSkill)
 
 	switch {
 	case atackerSkill < defenderSkill-91:
 		return 0
 	case atackerSkill >= defenderSkill-91 && atackerSkill < defenderSkill-74:
-		return ProbabilisticIncrement14()
+		return ProbabilisticIncrement14(rng)
 	case atackerSkill >= defenderSkill-74 && atackerSkill < defenderSkill-69:
-		return ProbabilisticIncrement20()
+		return ProbabilisticIncrement20(rng)
 	case atackerSkill >= defenderSkill-69 && atackerSkill < defenderSkill-61:
-		return ProbabilisticIncrement25()
+		return ProbabilisticIncrement25(rng)
 	case atackerSkill >= defenderSkill-61 && atackerSkill < defenderSkill-52:
-		return ProbabilisticIncrement33()
+		return ProbabilisticIncrement33(rng)
 	case atackerSkill >= defenderSkill-52 && atackerSkill < defenderSkill-43:
-		return ProbabilisticIncrement40()
+		return ProbabilisticIncrement40(rng)
 	case atackerSkill >= defenderSkill-43 && atackerSkill < defenderSkill-30:
-		return ProbabilisticIncrement44()
+		return ProbabilisticIncrement44(rng)
 	case atackerSkill >= defenderSkill-30 && atackerSkill < defenderSkill-12:
-		return ProbabilisticIncrement50()
+		return ProbabilisticIncrement50(rng)
 	case atackerSkill >= defenderSkill-12 && atackerSkill < defenderSkill:
-		return ProbabilisticIncrement57()
+		return ProbabilisticIncrement57(rng)
 	case atackerSkill >= defenderSkill && atackerSkill < defenderSkill+20:
-		return ProbabilisticIncrement62()
+		return ProbabilisticIncrement62(rng)
 	case atackerSkill >= defenderSkill+20 && atackerSkill < defenderSkill+33:
-		return ProbabilisticIncrement66()
+		return ProbabilisticIncrement66(rng)
 	case atackerSkill >= defenderSkill+33 && atackerSkill < defenderSkill-37:
-		return ProbabilisticIncrement71()
+		return ProbabilisticIncrement71(rng)
 	case atackerSkill >= defenderSkill+37 && atackerSkill < defenderSkill+49:
-		return ProbabilisticIncrement75()
+		return ProbabilisticIncrement75(rng)
 	case atackerSkill >= defenderSkill+49 && atackerSkill < defenderSkill+64:
-		return ProbabilisticIncrement80()
+		return ProbabilisticIncrement80(rng)
 	case atackerSkill >= defenderSkill+64 && atackerSkill < defenderSkill+77:
-		return ProbabilisticIncrement90()
+		return ProbabilisticIncrement90(rng)
 	case atackerSkill >= defenderSkill+77 && atackerSkill < defenderSkill+96:
-		return ProbabilisticIncrement94()
+		return ProbabilisticIncrement94(rng)
 	case atackerSkill >= defenderSkill+96:
 		return 1
 
@@ -105,18 +111,19 @@ func CalculateSuccessConfrontation(atackerSkill, defenderSkill int) int {
 	}
 }
 
-func KeyPass(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func KeyPass(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 
-	passer := GetRandomMidfielder(lineup.Players)
-	receiver := GetRandomForward(lineup.Players)
+	passer := GetRandomMidfielder(rng, lineup.Players)
+	receiver := GetRandomForward(rng, lineup.Players)
 	log.Printf("Selected passer: %+v, receiver: %+v", passer, receiver)
 
 	if passer == nil || receiver == nil {
-		return "There are not enough players available to make a pass", 0, 0, 0, 0, fmt.Errorf("There are not enough players available to make a pass")
+		return domain.EventOutcome{}, fmt.Errorf("There are not enough players available to make a pass")
 	}
-	successfulPass := CalculateSuccessIndividualEvent(passer.Technique)
+	successfulPass := CalculateSuccessIndividualEvent(rng, passer.Technique)
 	var sentence string
 	var lineupChances, rivalChances, lineupGoals, rivalGoals int
+	actors := domain.EventActors{PlayerID: playerID(passer), SecondaryPlayerID: playerID(receiver), Outcome: domain.OutcomeFailed}
 
 	if successfulPass == 1 {
 		log.Printf("Pass success calculated: %d", successfulPass)
@@ -124,13 +131,19 @@ func KeyPass(lineup, rivalLineup domain.Team) (string, int, int, int, int, error
 		log.Println(sentence)
 
 		lineupChances = 1
-		if resultOfEvent := ProbabilisticIncrement14(); resultOfEvent == 1 {
-			PenaltyKick(lineup, rivalLineup)
+		actors.Outcome = domain.OutcomeCompleted
+		var followUps []domain.FollowUp
+		if resultOfEvent := ProbabilisticIncrement14(rng); resultOfEvent == 1 {
+			penalty, err := PenaltyKick(rng, lineup, rivalLineup)
+			followUps = append(followUps, followUp(EventTypePenaltyKick, false, penalty, err)...)
 		} else {
-			Shot(lineup, rivalLineup, passer)
+			shot, err := Shot(rng, lineup, rivalLineup, passer)
+			followUps = append(followUps, followUp(EventTypeShot, false, shot, err)...)
 		}
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		outcome := eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors)
+		outcome.FollowUps = followUps
+		return outcome, nil
 	}
 
 	sentence = fmt.Sprintf("%s fails to make a key pass to %s.", passer.LastName, receiver.LastName)
@@ -138,30 +151,35 @@ func KeyPass(lineup, rivalLineup domain.Team) (string, int, int, int, int, error
 
 	lineupChances = 0
 
-	return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+	return eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 }
 
-func Shot(lineup, rivalLineup domain.Team, passer *domain.Player) (string, int, int, int, int, error) {
+func Shot(rng *rand.Rand, lineup, rivalLineup domain.Team, passer *domain.Player) (domain.EventOutcome, error) {
 
-	shooter := GetRandomForward(lineup.Players)
+	shooter := GetRandomForward(rng, lineup.Players)
 	if shooter == nil {
-		return "no forward player found in lineup", 0, 0, 0, 0, errors.New("no forward player found in lineup")
+		return domain.EventOutcome{}, errors.New("no forward player found in lineup")
 	}
-	goalkeeper := GetGoalkeeper(rivalLineup.Players)
+	goalkeeper := GetGoalkeeper(rng, rivalLineup.Players)
 	if goalkeeper == nil {
-		return "no goalkeeper found in rival lineup", 0, 0, 0, 0, errors.New("no goalkeeper found in rival lineup")
+		return domain.EventOutcome{}, errors.New("no goalkeeper found in rival lineup")
 	}
-	defender := GetRandomDefender(rivalLineup.Players)
+	defender := GetRandomDefender(rng, rivalLineup.Players)
 	if defender == nil {
-		return "no defender player found in lineup", 0, 0, 0, 0, errors.New("no defender player found in lineup")
+		return domain.EventOutcome{}, errors.New("no defender player found in lineup")
 	}
 
 	log.Printf("Shooter: %+v, Defender: %+v, Goalkeeper: %+v", shooter, defender, goalkeeper)
+	xg := ConfrontationChance(shooter.Technique, defender.Technique) * ConfrontationChance(shooter.Technique, goalkeeper.Technique)
 
 	var sentence string
 	var lineupChances, rivalChances, lineupGoals, rivalGoals int
+	actors := domain.EventActors{PlayerID: playerID(shooter), OpponentPlayerID: playerID(defender), Outcome: domain.OutcomeBlocked}
+	if passer != nil && passer.PlayerId != shooter.PlayerId {
+		actors.SecondaryPlayerID = playerID(passer)
+	}
 
-	successfulAgainstDefender := CalculateSuccessConfrontation(shooter.Technique, defender.Technique)
+	successfulAgainstDefender := CalculateSuccessConfrontation(rng, shooter.Technique, defender.Technique)
 	log.Printf("Success against defender: %d", successfulAgainstDefender)
 
 	if successfulAgainstDefender == 1 {
@@ -171,20 +189,23 @@ func Shot(lineup, rivalLineup domain.Team, passer *domain.Player) (string, int,
 
 		log.Printf("%s supera a %s.\n", shooter.LastName, defender.LastName)
 
-		successfulAgainstGoalkeeper := CalculateSuccessConfrontation(shooter.Technique, goalkeeper.Technique)
+		successfulAgainstGoalkeeper := CalculateSuccessConfrontation(rng, shooter.Technique, goalkeeper.Technique)
+		actors.OpponentPlayerID = playerID(goalkeeper)
+		actors.Outcome = domain.OutcomeSaved
 
 		if successfulAgainstGoalkeeper == 1 {
 			sentence += fmt.Sprintf(" %s shoots and also beats the goalkeeper... GOOOOOAL! %s is just a spectator in the play %s scores a goal!\n", shooter.LastName, goalkeeper.LastName, shooter.LastName)
 			log.Println(sentence)
 			log.Println("The striker also beats the goalkeeper, it's a GOAL")
 			lineupGoals = 1
+			actors.Outcome = domain.OutcomeGoal
 
 			if passer != nil {
 				log.Printf("the passer is: %v", passer)
 
 			}
 
-			return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+			return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 		} else {
 			sentence += fmt.Sprintf(" %s's shot is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 			log.Println(sentence)
@@ -197,25 +218,32 @@ func Shot(lineup, rivalLineup domain.Team, passer *domain.Player) (string, int,
 		log.Println(sentence)
 
 		lineupChances = 0
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
-	return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+	return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 }
 
-func PenaltyKick(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
-	shooter := GetRandomForward(lineup.Players)
+func PenaltyKick(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
+	shooter := GetRandomForward(rng, lineup.Players)
 	if shooter == nil {
-		return "no forward player found in lineup", 0, 0, 0, 0, errors.New("no forward player found in lineup")
+		return domain.EventOutcome{}, errors.New("no forward player found in lineup")
 	}
-	goalkeeper := GetGoalkeeper(rivalLineup.Players)
+	return penaltyBy(rng, shooter, rivalLineup)
+}
+
+// penaltyBy plays a penalty taken by shooter against the rival goalkeeper.
+func penaltyBy(rng *rand.Rand, shooter *domain.Player, rivalLineup domain.Team) (domain.EventOutcome, error) {
+	goalkeeper := GetGoalkeeper(rng, rivalLineup.Players)
 	if goalkeeper == nil {
-		return "no goalkeeper found in rival lineup", 0, 0, 0, 0, errors.New("no goalkeeper found in rival lineup")
+		return domain.EventOutcome{}, errors.New("no goalkeeper found in rival lineup")
 	}
+	actors := domain.EventActors{PlayerID: playerID(shooter), OpponentPlayerID: playerID(goalkeeper), Outcome: domain.OutcomeSaved}
 
-	increasedShooterMental := shooter.Mental + (10 * rand.Intn(3))
+	increasedShooterMental := shooter.Mental + (10 * rng.Intn(3))
 	decreasedGoalkeeperMental := goalkeeper.Mental - 5
+	xg := ConfrontationChance(increasedShooterMental, decreasedGoalkeeperMental)
 
-	successfulPenalty := CalculateSuccessConfrontation(increasedShooterMental, decreasedGoalkeeperMental)
+	successfulPenalty := CalculateSuccessConfrontation(rng, increasedShooterMental, decreasedGoalkeeperMental)
 
 	var sentence string
 	var lineupChances, rivalChances, lineupGoals, rivalGoals int
@@ -225,33 +253,36 @@ func PenaltyKick(lineup, rivalLineup domain.Team) (string, int, int, int, int, e
 		log.Println(sentence)
 
 		lineupGoals = 1
+		actors.Outcome = domain.OutcomeGoal
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	} else {
 		sentence = fmt.Sprintf("%s's penalty is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 		log.Println(sentence)
 
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
 }
 
-func LongShot(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func LongShot(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 
-	shooter := GetRandomForward(lineup.Players)
+	shooter := GetRandomForward(rng, lineup.Players)
 	if shooter == nil {
-		return "no forward player found in lineup", 0, 0, 0, 0, errors.New("no forward player found in lineup")
+		return domain.EventOutcome{}, errors.New("no forward player found in lineup")
 	}
-	goalkeeper := GetGoalkeeper(rivalLineup.Players)
+	goalkeeper := GetGoalkeeper(rng, rivalLineup.Players)
 	if goalkeeper == nil {
-		return "no goalkeeper found in rival lineup", 0, 0, 0, 0, errors.New("no goalkeeper found in rival lineup")
+		return domain.EventOutcome{}, errors.New("no goalkeeper found in rival lineup")
 	}
+	actors := domain.EventActors{PlayerID: playerID(shooter), OpponentPlayerID: playerID(goalkeeper), Outcome: domain.OutcomeSaved}
 
-	decreasedShooterTechnique := shooter.Technique - (6 * rand.Intn(4))
+	decreasedShooterTechnique := shooter.Technique - (6 * rng.Intn(4))
+	xg := ConfrontationChance(decreasedShooterTechnique, goalkeeper.Mental)
 
-	successfulLongShot := CalculateSuccessConfrontation(decreasedShooterTechnique, goalkeeper.Mental)
+	successfulLongShot := CalculateSuccessConfrontation(rng, decreasedShooterTechnique, goalkeeper.Mental)
 
 	lineupChances := 1
 	rivalChances := 0
@@ -263,45 +294,48 @@ func LongShot(lineup, rivalLineup domain.Team) (string, int, int, int, int, erro
 		log.Printf("GOAL! %s scores a long shot!\n", shooter.LastName)
 
 		lineupGoals = 1
+		actors.Outcome = domain.OutcomeGoal
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	} else {
 		sentence := fmt.Sprintf("%s's long shot is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 		log.Printf("SAVE! %s's long shot is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
 }
 
-func IndirectFreeKick(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func IndirectFreeKick(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 
-	shooter := GetRandomMidfielder(lineup.Players)
+	shooter := GetRandomMidfielder(rng, lineup.Players)
 	if shooter == nil {
-		return "no shooter player found in lineup", 0, 0, 0, 0, errors.New("no shooter player found in lineup")
+		return domain.EventOutcome{}, errors.New("no shooter player found in lineup")
 	}
-	defenderOnAttack := GetRandomDefender(lineup.Players)
+	defenderOnAttack := GetRandomDefender(rng, lineup.Players)
 	if defenderOnAttack == nil {
-		return "no defender player found in lineup", 0, 0, 0, 0, errors.New("no defender player found in lineup")
+		return domain.EventOutcome{}, errors.New("no defender player found in lineup")
 	}
-	rivalDefender := GetRandomDefender(rivalLineup.Players)
+	rivalDefender := GetRandomDefender(rng, rivalLineup.Players)
 	if rivalDefender == nil {
-		return "no rivalDefender player found in lineup", 0, 0, 0, 0, errors.New("no rivalDefender player found in lineup")
+		return domain.EventOutcome{}, errors.New("no rivalDefender player found in lineup")
 	}
-	goalkeeper := GetGoalkeeper(rivalLineup.Players)
+	goalkeeper := GetGoalkeeper(rng, rivalLineup.Players)
 	if goalkeeper == nil {
-		return "no goalkeeper found in rival lineup", 0, 0, 0, 0, errors.New("no goalkeeper found in rival lineup")
+		return domain.EventOutcome{}, errors.New("no goalkeeper found in rival lineup")
 	}
+	actors := domain.EventActors{PlayerID: playerID(shooter), OpponentPlayerID: playerID(goalkeeper), Outcome: domain.OutcomeSaved}
 
-	increasedShooterTechnique := shooter.Technique + (4 * rand.Intn(6))
-	increasedRivalDefenderPhysique := rivalDefender.Physique + rand.Intn(30)
+	increasedShooterTechnique := shooter.Technique + (4 * rng.Intn(6))
+	increasedRivalDefenderPhysique := rivalDefender.Physique + rng.Intn(30)
 
 	attackAtributes := increasedShooterTechnique + defenderOnAttack.Physique
 	defenseAtributes := increasedRivalDefenderPhysique + goalkeeper.Technique
+	xg := ConfrontationChance(attackAtributes, defenseAtributes)
 
-	successfulLongShot := CalculateSuccessConfrontation(attackAtributes, defenseAtributes)
+	successfulLongShot := CalculateSuccessConfrontation(rng, attackAtributes, defenseAtributes)
 
 	lineupChances := 1
 	rivalChances := 0
@@ -313,73 +347,81 @@ func IndirectFreeKick(lineup, rivalLineup domain.Team) (string, int, int, int, i
 		log.Printf("GOAL! %s scores a long shot!\n", shooter.LastName)
 
 		lineupGoals = 1
+		actors = domain.EventActors{PlayerID: playerID(defenderOnAttack), SecondaryPlayerID: playerID(shooter), OpponentPlayerID: playerID(goalkeeper), Outcome: domain.OutcomeGoal}
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	} else {
 		sentence := fmt.Sprintf("%s's long shot is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 		log.Printf("SAVE! %s's long shot is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
 }
 
-func Dribble(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func Dribble(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 	var dribbler, defender *domain.Player
 	var sentence string
 	var lineupChances, rivalChances, lineupGoals, rivalGoals int
 
-	prob := ProbabilisticIncrement20() + ProbabilisticIncrement20()
+	prob := ProbabilisticIncrement20(rng) + ProbabilisticIncrement20(rng)
 	if prob <= 0 {
-		dribbler = GetRandomMidfielder(lineup.Players)
-		defender = GetRandomMidfielder(rivalLineup.Players)
+		dribbler = GetRandomMidfielder(rng, lineup.Players)
+		defender = GetRandomMidfielder(rng, rivalLineup.Players)
 	} else if prob <= 1 {
-		dribbler = GetRandomForward(lineup.Players)
-		defender = GetRandomDefender(rivalLineup.Players)
+		dribbler = GetRandomForward(rng, lineup.Players)
+		defender = GetRandomDefender(rng, rivalLineup.Players)
 	} else if prob > 1 {
-		dribbler = GetRandomMidfielder(lineup.Players)
-		defender = GetRandomDefender(rivalLineup.Players)
+		dribbler = GetRandomMidfielder(rng, lineup.Players)
+		defender = GetRandomDefender(rng, rivalLineup.Players)
 	}
 
 	log.Printf("Selected passer: %+v, receiver: %+v", dribbler, defender)
 
 	if dribbler == nil || defender == nil {
-		return "There are not enough players available to make a pass", 0, 0, 0, 0, fmt.Errorf("There are not enough players available to make a pass")
+		return domain.EventOutcome{}, fmt.Errorf("There are not enough players available to make a pass")
 	}
 
 	sentence = fmt.Sprintf("%s tries a dribbling", dribbler.LastName)
-	successfulDribble := CalculateSuccessIndividualEvent(dribbler.Technique)
+	actors := domain.EventActors{PlayerID: playerID(dribbler), OpponentPlayerID: playerID(defender), Outcome: domain.OutcomeFailed}
+	successfulDribble := CalculateSuccessIndividualEvent(rng, dribbler.Technique)
 
 	if successfulDribble == 1 {
 		log.Printf("Pass success calculated: %d", successfulDribble)
 		sentence += " and succeeds..."
 		log.Println(sentence)
 
-		successfulConfrontation := CalculateSuccessConfrontation(dribbler.Technique, defender.Technique)
+		successfulConfrontation := CalculateSuccessConfrontation(rng, dribbler.Technique, defender.Technique)
 		if successfulConfrontation == 1 {
 			sentence += fmt.Sprintf(" %s dribbled %s...", dribbler.LastName, defender.LastName)
 
 			lineupChances = 1
+			actors.Outcome = domain.OutcomeCompleted
 
-			if resultOfEvent := ProbabilisticIncrement40(); resultOfEvent == 1 {
+			var followUps []domain.FollowUp
+			if resultOfEvent := ProbabilisticIncrement40(rng); resultOfEvent == 1 {
 				sentence += " the occasion ends with a shot"
 				log.Println("the occasion ends with a shot")
-				Shot(lineup, rivalLineup, dribbler)
+				shot, err := Shot(rng, lineup, rivalLineup, dribbler)
+				followUps = append(followUps, followUp(EventTypeShot, false, shot, err)...)
 			} else {
 				sentence += " the occasion ends with a foul"
-				log.Println("the occasion ends with a shot")
-				Foul(lineup, rivalLineup, defender)
+				log.Println("the occasion ends with a foul")
+				foul, err := Foul(rng, lineup, rivalLineup)
+				followUps = append(followUps, followUp(EventTypeFoul, false, foul, err)...)
 			}
 
-			return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+			outcome := eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors)
+			outcome.FollowUps = followUps
+			return outcome, nil
 		} else {
 			sentence += fmt.Sprintf(" but %s lost the dribbled against %s", dribbler.LastName, defender.LastName)
 
 		}
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
 	sentence += fmt.Sprintf(" Oh Noo, %s trips over the ball, and fails the dribble", dribbler.LastName)
 
@@ -387,85 +429,75 @@ func Dribble(lineup, rivalLineup domain.Team) (string, int, int, int, int, error
 
 	lineupChances = 0
 
-	return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+	return eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 }
 
-func Foul(lineup, rivalLineup domain.Team, defender *domain.Player) (string, int, int, int, int, error) {
+func Foul(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 	var sentence string
 	var lineupChances, rivalChances, lineupGoals, rivalGoals int
+	var actors domain.EventActors
 
-	probabilyYellowOrRedCard := ProbabilisticIncrement40() + ProbabilisticIncrement20()
-
-	resultOfEvent := ProbabilisticIncrement50() + ProbabilisticIncrement33() + ProbabilisticIncrement33()
+	resultOfEvent := ProbabilisticIncrement50(rng) + ProbabilisticIncrement33(rng) + ProbabilisticIncrement33(rng)
 
-	if probabilyYellowOrRedCard >= 1 {
-		sentence = "the referee puts his hand in his pocket"
-		YellowOrRedCard(lineup, defender)
-	}
 	if resultOfEvent >= 2 {
 		sentence = "the foul is in the middle of the field"
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
+	var followUps []domain.FollowUp
 	if resultOfEvent >= 1 {
 		sentence = "the foul is in the middle of the field"
-		IndirectFreeKick(lineup, rivalLineup)
-
+		freeKick, err := IndirectFreeKick(rng, lineup, rivalLineup)
+		followUps = append(followUps, followUp(EventTypeIndirectFreeKick, false, freeKick, err)...)
 	} else {
 		sentence = "the foul is in a dangerous area of the field"
-		DirectFreeKick(lineup, rivalLineup)
+		freeKick, err := DirectFreeKick(rng, lineup, rivalLineup)
+		followUps = append(followUps, followUp(EventTypeDirectFreeKick, false, freeKick, err)...)
 	}
-	return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+	outcome := eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors)
+	outcome.FollowUps = followUps
+	return outcome, nil
 
 }
 
-func YellowOrRedCard(lineup domain.Team, defender *domain.Player) (string, int, int, int, int, error) {
-	var sentence string
-	var lineupChances, rivalChances, lineupGoals, rivalGoals, probabilyYellowCard int
-	sentence = "The referee puts his hand in his pocket"
-
-	if defender == nil {
-		defender = GetRandomDefender(lineup.Players)
-		if defender == nil {
-			return "no defender player found in lineup", 0, 0, 0, 0, errors.New("no defender player found in lineup")
-		}
+// YellowOrRedCard decides whether the referee books the player who committed
+// a foul. Most bookings are yellow; aggressive players see a straight red more
+// often.
+func YellowOrRedCard(rng *rand.Rand, defender domain.Player) (domain.Card, bool) {
+	probabilyYellowOrRedCard := ProbabilisticIncrement40(rng) + ProbabilisticIncrement20(rng)
+	if probabilyYellowOrRedCard < 1 {
+		return "", false
 	}
 
-	probabilyIncrementByAgressive := CalculateSuccessIndividualEvent(defender.Mental)
-
+	var probabilyYellowCard int
+	probabilyIncrementByAgressive := CalculateSuccessIndividualEvent(rng, defender.Mental)
 	if probabilyIncrementByAgressive >= 1 {
-		probabilyYellowCard = ProbabilisticIncrement62()
+		probabilyYellowCard = ProbabilisticIncrement90(rng)
 	} else {
-		probabilyYellowCard = ProbabilisticIncrement75()
+		probabilyYellowCard = ProbabilisticIncrement94(rng)
 	}
 
 	if probabilyYellowCard >= 1 {
-		sentence += fmt.Sprintf("The referee gives %v a yellow card", defender.LastName)
-
-	} else {
-
-		sentence += fmt.Sprintf("The referee gives %v a red card", defender.LastName)
-		log.Println("the player was expelled from the lineup")
-
+		return domain.CardYellow, true
 	}
-
-	return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
-
+	return domain.CardRed, true
 }
 
-func DirectFreeKick(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func DirectFreeKick(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 
-	shooter := GetRandomForward(lineup.Players)
+	shooter := GetRandomForward(rng, lineup.Players)
 	if shooter == nil {
-		return "no forward player found in lineup", 0, 0, 0, 0, errors.New("no forward player found in lineup")
+		return domain.EventOutcome{}, errors.New("no forward player found in lineup")
 	}
-	goalkeeper := GetGoalkeeper(rivalLineup.Players)
+	goalkeeper := GetGoalkeeper(rng, rivalLineup.Players)
 	if goalkeeper == nil {
-		return "no goalkeeper found in rival lineup", 0, 0, 0, 0, errors.New("no goalkeeper found in rival lineup")
+		return domain.EventOutcome{}, errors.New("no goalkeeper found in rival lineup")
 	}
+	actors := domain.EventActors{PlayerID: playerID(shooter), OpponentPlayerID: playerID(goalkeeper), Outcome: domain.OutcomeSaved}
 
-	decreasedShooterTechnique := shooter.Technique - (6 * rand.Intn(7))
+	decreasedShooterTechnique := shooter.Technique - (6 * rng.Intn(7))
+	xg := ConfrontationChance(decreasedShooterTechnique, goalkeeper.Technique)
 
-	successfulLongShot := CalculateSuccessConfrontation(decreasedShooterTechnique, goalkeeper.Technique)
+	successfulLongShot := CalculateSuccessConfrontation(rng, decreasedShooterTechnique, goalkeeper.Technique)
 
 	lineupChances := 1
 	rivalChances := 0
@@ -477,163 +509,203 @@ func DirectFreeKick(lineup, rivalLineup domain.Team) (string, int, int, int, int
 		log.Printf("GOAL! %s scores a free kick long shot!\n", shooter.LastName)
 
 		lineupGoals = 1
+		actors.Outcome = domain.OutcomeGoal
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	} else {
 		sentence := fmt.Sprintf("%s's free kick shot is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 		log.Printf("SAVE! %s's free kick is saved by %s.\n", shooter.LastName, goalkeeper.LastName)
 
 		lineupChances = 1
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
 }
 
-func GreatScoringChance(lineup domain.Team) (string, int, int, int, int, error) {
+func GreatScoringChance(rng *rand.Rand, lineup domain.Team) (domain.EventOutcome, error) {
 	var shooter *domain.Player
 	var sentence string
 	var lineupChances, rivalChances, lineupGoals, rivalGoals int
-	prob := ProbabilisticIncrement66()
+	xg := greatChanceXG
+	prob := ProbabilisticIncrement66(rng)
 	if prob == 1 {
-		shooter = GetRandomForward(lineup.Players)
+		shooter = GetRandomForward(rng, lineup.Players)
 		if shooter == nil {
-			return "No player available for scoring", 0, 0, 0, 0, fmt.Errorf("no player available for scoring")
+			return domain.EventOutcome{}, fmt.Errorf("no player available for scoring")
 		}
 	} else {
-		shooter = GetRandomMidfielder(lineup.Players)
+		shooter = GetRandomMidfielder(rng, lineup.Players)
 		if shooter == nil {
-			return "No player available for scoring", 0, 0, 0, 0, fmt.Errorf("no player available for scoring")
+			return domain.EventOutcome{}, fmt.Errorf("no player available for scoring")
 		}
 	}
-	prob = ProbabilisticIncrement71()
+	prob = ProbabilisticIncrement71(rng)
 	lineupChances = 1
+	actors := domain.EventActors{PlayerID: playerID(shooter), Outcome: domain.OutcomeMissed}
 	if prob == 1 {
 		lineupGoals = 1
+		actors.Outcome = domain.OutcomeGoal
 		sentence = fmt.Sprintf("%s score a great easy chance", shooter.LastName)
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	} else {
 		sentence = fmt.Sprintf("%s fails miserably with a very clear scoring chance", shooter.LastName)
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 
 	}
 }
 
-func CornerKick(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func CornerKick(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 	var centerer *domain.Player
 	var attacker, defender *domain.Player
 	var sentence string
 	var lineupChances, rivalChances, lineupGoals, rivalGoals int
+	var xg float64
 
-	centerer = GetRandomMidfielder(lineup.Players)
+	centerer = GetRandomMidfielder(rng, lineup.Players)
 	if centerer == nil {
-		return "", 0, 0, 0, 0, fmt.Errorf("no midfielder found for centerer")
+		return domain.EventOutcome{}, fmt.Errorf("no midfielder found for centerer")
 	}
 
-	incrementedTechnique := centerer.Technique + rand.Intn(20)
-	prob := CalculateSuccessIndividualEvent(incrementedTechnique)
+	incrementedTechnique := centerer.Technique + rng.Intn(20)
+	prob := CalculateSuccessIndividualEvent(rng, incrementedTechnique)
 	lineupChances = 1
+	actors := domain.EventActors{PlayerID: playerID(centerer), Outcome: domain.OutcomeFailed}
 
 	if prob == 1 {
-		defender = GetRandomDefender(rivalLineup.Players)
-		prob = ProbabilisticIncrement62()
+		defender = GetRandomDefender(rng, rivalLineup.Players)
+		prob = ProbabilisticIncrement62(rng)
 		if prob == 1 {
-			attacker = GetRandomDefender(lineup.Players)
+			attacker = GetRandomDefender(rng, lineup.Players)
 		} else {
-			attacker = GetRandomMidfielder(lineup.Players)
+			attacker = GetRandomMidfielder(rng, lineup.Players)
+		}
+		if attacker == nil || defender == nil {
+			return domain.EventOutcome{}, fmt.Errorf("no players available to fight for the corner")
+		}
+		prob = CalculateSuccessConfrontation(rng, attacker.Physique, defender.Physique)
+		actors = domain.EventActors{PlayerID: playerID(attacker), OpponentPlayerID: playerID(defender), Outcome: domain.OutcomeBlocked}
+		if attacker.PlayerId != centerer.PlayerId {
+			actors.SecondaryPlayerID = playerID(centerer)
 		}
-		prob = CalculateSuccessConfrontation(attacker.Physique, defender.Physique)
+		xg = ConfrontationChance(attacker.Physique, defender.Physique)
 		if prob == 1 {
 			sentence = fmt.Sprintf("GOOOOOAL, %s took the corner very well, and %s beats %s with a incredible jump and heads at goal", centerer.LastName, attacker.LastName, defender.LastName)
 			lineupGoals = 1
+			actors.Outcome = domain.OutcomeGoal
 
-			return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+			return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 		} else {
 			sentence = fmt.Sprintf("%s takes the corner... but %s beats %s to the jump and clears the ball", centerer.LastName, defender.LastName, attacker.LastName)
 
-			return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+			return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 		}
 	} else {
 		sentence = fmt.Sprintf("the corner was wasted by %s", centerer.LastName)
 
-		return sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, nil
+		return shotOutcome(xg, sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors), nil
 	}
 }
 
-func InjuryDuringMatch(lineup domain.Team) (string, int, int, int, int, error) {
+func InjuryDuringMatch(rng *rand.Rand, lineup domain.Team) (string, *domain.Player, error) {
 	var injuredPlayer *domain.Player
 	var sentence string
-	injuredPlayer = GetRandomPlayerExcludingGoalkeeper(lineup.Players)
+	injuredPlayer = GetRandomPlayerExcludingGoalkeeper(rng, lineup.Players)
 	if injuredPlayer == nil {
-		return "", 0, 0, 0, 0, fmt.Errorf("no midfielder found for injuredPlayer")
+		return "", nil, fmt.Errorf("no outfield player found for injuredPlayer")
 	}
 	sentence = fmt.Sprintf("There is a player lying on the ground... wow he is %s, he looks like he will need assistance...", injuredPlayer.LastName)
 
-	return sentence, 0, 0, 0, 0, nil
+	return sentence, injuredPlayer, nil
 }
 
-func Offside(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+// InjurySeverityAndDays decides how bad an injury is and how many days the
+// player will be out. Stronger players are less likely to get a serious one.
+func InjurySeverityAndDays(rng *rand.Rand, player domain.Player) (domain.InjurySeverity, int) {
+	roll := rng.Intn(100) + player.Physique/20
+	switch {
+	case roll < 8:
+		return domain.InjurySerious, 29 + rng.Intn(62)
+	case roll < 40:
+		return domain.InjuryModerate, 8 + rng.Intn(21)
+	default:
+		return domain.InjuryMinor, 2 + rng.Intn(6)
+	}
+}
+
+func Offside(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 	var passer, playerOffside *domain.Player
 	var lineupChances int
 	var sentence string
 
-	passer = GetRandomMidfielder(lineup.Players)
+	passer = GetRandomMidfielder(rng, lineup.Players)
 	if passer == nil {
-		return "", 0, 0, 0, 0, fmt.Errorf("no midfielder found for passer")
+		return domain.EventOutcome{}, fmt.Errorf("no midfielder found for passer")
 	}
 
-	playerOffside = GetRandomForward(lineup.Players)
+	playerOffside = GetRandomForward(rng, lineup.Players)
 	if playerOffside == nil {
-		return "", 0, 0, 0, 0, fmt.Errorf("no midfielder found for playerOffside")
+		return domain.EventOutcome{}, fmt.Errorf("no midfielder found for playerOffside")
 	}
 
 	lineupChances = 1
+	actors := domain.EventActors{PlayerID: playerID(playerOffside), SecondaryPlayerID: playerID(passer), Outcome: domain.OutcomeOffside}
 
 	sentence = fmt.Sprintf("%s looks a pass... ", passer.LastName)
 	sentence += fmt.Sprintf("%s runs behind the rival defense", playerOffside.LastName)
 
-	prob := ProbabilisticIncrement66()
+	var followUps []domain.FollowUp
+	prob := ProbabilisticIncrement66(rng)
 	if prob >= 1 {
 		sentence += "%s its offside, the opportunity is lost"
 
 	} else {
 		sentence += "great pass bordering on offside"
+		actors.Outcome = domain.OutcomeCompleted
 
-		Shot(lineup, rivalLineup, passer)
+		shot, err := Shot(rng, lineup, rivalLineup, passer)
+		followUps = append(followUps, followUp(EventTypeShot, false, shot, err)...)
 	}
 
-	return sentence, lineupChances, 0, 0, 0, nil
+	outcome := eventOutcome(sentence, lineupChances, 0, 0, 0, actors)
+	outcome.FollowUps = followUps
+	return outcome, nil
 }
 
-func Headed(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func Headed(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 	var header, rivalHeader *domain.Player
 	var sentence string
 	var lineupChances, rivalChances int
 
-	header = GetRandomPlayerExcludingGoalkeeper(lineup.Players)
-	rivalHeader = GetRandomPlayerExcludingGoalkeeper(rivalLineup.Players)
+	header = GetRandomPlayerExcludingGoalkeeper(rng, lineup.Players)
+	rivalHeader = GetRandomPlayerExcludingGoalkeeper(rng, rivalLineup.Players)
 	if header == nil || rivalHeader == nil {
 		fmt.Println("header or rivalHeader es nil")
-		return "", 0, 0, 0, 0, fmt.Errorf("no rival player available for the header duel")
+		return domain.EventOutcome{}, fmt.Errorf("no rival player available for the header duel")
 	}
 	sentence = "The ball comes through the air, here we have an aerial duel"
 
-	success := CalculateSuccessConfrontation(header.Physique, rivalHeader.Physique)
+	success := CalculateSuccessConfrontation(rng, header.Physique, rivalHeader.Physique)
+	actors := domain.EventActors{PlayerID: playerID(header), OpponentPlayerID: playerID(rivalHeader), Outcome: domain.OutcomeFailed}
+	var followUps []domain.FollowUp
 	if success == 1 {
 		lineupChances = 1
+		actors.Outcome = domain.OutcomeCompleted
 		sentence += fmt.Sprintf("%s wins a header in midfield against %s", header.LastName, rivalHeader.LastName)
 
-		LongShot(lineup, rivalLineup)
+		shot, err := LongShot(rng, lineup, rivalLineup)
+		followUps = append(followUps, followUp(EventTypeLongShot, false, shot, err)...)
 	} else {
 		sentence += fmt.Sprintf("%s loses a header in midfield against %s", header.LastName, rivalHeader.LastName)
-		prob := ProbabilisticIncrement75()
+		prob := ProbabilisticIncrement75(rng)
 		if prob >= 1 {
 			rivalChances = 1
 			sentence += fmt.Sprintf("%s makes a long pass, and his teammates run away", rivalHeader.LastName)
 
-			CounterAttack(rivalLineup, lineup)
+			counter, err := CounterAttack(rng, rivalLineup, lineup)
+			followUps = append(followUps, followUp(EventTypeCounterAttack, true, counter, err)...)
 
 		} else {
 			sentence += fmt.Sprintf("%s kick the ball into the air, and there are no second plays", rivalHeader.LastName)
@@ -641,25 +713,61 @@ func Headed(lineup, rivalLineup domain.Team) (string, int, int, int, int, error)
 		}
 
 	}
-	return sentence, lineupChances, rivalChances, 0, 0, nil
+	outcome := eventOutcome(sentence, lineupChances, rivalChances, 0, 0, actors)
+	outcome.FollowUps = followUps
+	return outcome, nil
 }
 
-func CounterAttack(lineup, rivalLineup domain.Team) (string, int, int, int, int, error) {
+func CounterAttack(rng *rand.Rand, lineup, rivalLineup domain.Team) (domain.EventOutcome, error) {
 	var sentence string
 
 	sentence = "Some players run out in counterattack"
-	prob := ProbabilisticIncrement66()
+	var followUps []domain.FollowUp
+	prob := ProbabilisticIncrement66(rng)
 	if prob >= 1 {
-		LongShot(lineup, rivalLineup)
+		shot, err := LongShot(rng, lineup, rivalLineup)
+		followUps = append(followUps, followUp(EventTypeLongShot, false, shot, err)...)
 	} else {
-		prob := ProbabilisticIncrement57()
+		prob := ProbabilisticIncrement57(rng)
 		if prob >= 1 {
 			sentence += "The rival stopped the counterattack with a foul"
-			IndirectFreeKick(lineup, rivalLineup)
+			freeKick, err := IndirectFreeKick(rng, lineup, rivalLineup)
+			followUps = append(followUps, followUp(EventTypeIndirectFreeKick, false, freeKick, err)...)
 		} else {
 			sentence += "The opponent breaks the counterattack cleanly"
 		}
 	}
 
-	return sentence, 0, 0, 0, 0, nil
+	outcome := eventOutcome(sentence, 0, 0, 0, 0, domain.EventActors{})
+	outcome.FollowUps = followUps
+	return outcome, nil
+}
+
+func eventOutcome(sentence string, lineupChances, rivalChances, lineupGoals, rivalGoals int, actors domain.EventActors) domain.EventOutcome {
+	return domain.EventOutcome{
+		Sentence:      sentence,
+		LineupChances: lineupChances,
+		RivalChances:  rivalChances,
+		LineupGoals:   lineupGoals,
+		RivalGoals:    rivalGoals,
+		EventActors:   actors,
+	}
+}
+
+// followUp chains the event that came out of another one. A follow-up that
+// could not be played is left out of the chain.
+func followUp(eventType EventType, rival bool, outcome domain.EventOutcome, err error) []domain.FollowUp {
+	if err != nil {
+		log.Printf("no %s follow-up: %v", eventType, err)
+		return nil
+	}
+	return []domain.FollowUp{{EventType: string(eventType), Rival: rival, EventOutcome: outcome}}
+}
+
+func playerID(player *domain.Player) *uuid.UUID {
+	if player == nil {
+		return nil
+	}
+	id := player.PlayerId
+	return &id
 }
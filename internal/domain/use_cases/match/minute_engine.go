@@ -0,0 +1,215 @@
+package match
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// Engine is how a Simulator plays a match. The event budget engine decides up
+// front how many events each side gets; the minute by minute engine decides
+// possession and events at every minute from the state of the match.
+type Engine string
+
+const (
+	EngineEventBudget    Engine = "event_budget"
+	EngineMinuteByMinute Engine = "minute_by_minute"
+)
+
+const (
+	// inMatchFatigue is the share of a player's strength lost after 90
+	// minutes on the pitch, less for players with a good physique.
+	inMatchFatigue = 0.15
+	// chasingIntent and protectingIntent are how much more (or less) a team
+	// attacks by the end of a period when it is behind (or ahead).
+	chasingIntent    = 0.4
+	protectingIntent = 0.3
+	// intentPossessionShift is how much of the difference in intent turns
+	// into possession for the team chasing the game.
+	intentPossessionShift = 0.1
+)
+
+// tempoEvents is how many events both teams play in 90 minutes for the sum of
+// their tempos, about as many as the event budget engine hands out.
+var tempoEvents = map[int]float64{2: 5.5, 3: 7.5, 4: 10, 5: 13, 6: 17}
+
+var gameTempos = map[string]int{
+	"slow_tempo":     1,
+	"balanced_tempo": 2,
+	"fast_tempo":     3,
+}
+
+// neutralStrategy leaves possession and chances to the players.
+var neutralStrategy = strategyResult{homePossession: 1, homeChances: 1, awayChances: 1}
+
+func ParseEngine(value string) (Engine, error) {
+	switch Engine(value) {
+	case "", EngineEventBudget:
+		return EngineEventBudget, nil
+	case EngineMinuteByMinute:
+		return EngineMinuteByMinute, nil
+	}
+	return "", fmt.Errorf("unknown match engine %q", value)
+}
+
+// playByMinute plays a period minute by minute, half by half, each half
+// followed by its stoppage time. Every minute one team has the ball and may
+// play an event, depending on the players left on the pitch, how tired they
+// are and whether they are chasing the game or protecting a lead.
+func (s Simulator) playByMinute(m *domain.Match, period Period) (domain.MatchEventStats, int, int) {
+	rng := s.rng
+	homeSide := s.side(m.HomeMatchStrategy.StrategyTeam)
+	awaySide := s.side(m.AwayMatchStrategy.StrategyTeam)
+	homeSide.startPeriod(period)
+	awaySide.startPeriod(period)
+	home, away := homeSide.team, awaySide.team
+
+	homeEvents := teamEvents(rng, &home, &away)
+	awayEvents := teamEvents(rng, &away, &home)
+
+	var homeTally, awayTally chainTally
+	var homeMinutes, awayMinutes int
+
+	var addedTime []int
+	for _, end := range period.halfEnds() {
+		start := end - period.Minutes/2
+		homeFrom, awayFrom := len(homeTally.results), len(awayTally.results)
+		stoppage := -1
+
+		for clock := start + 1; stoppage < 0 || clock <= end+stoppage; clock++ {
+			if clock == end+1 {
+				halfEvents := append(append([]domain.EventResult{}, homeTally.results[homeFrom:]...), awayTally.results[awayFrom:]...)
+				stoppage = stoppageTime(halfEvents)
+				addedTime = append(addedTime, stoppage)
+				log.Printf("%d minutes of stoppage time at minute %d", stoppage, end)
+			}
+			minute, added := min(clock, end), max(clock-end, 0)
+			homeBefore, awayBefore := len(homeTally.results), len(awayTally.results)
+
+			homeTally.results = append(homeTally.results, homeSide.plannedChanges(minute, homeTally.goals-awayTally.goals)...)
+			awayTally.results = append(awayTally.results, awaySide.plannedChanges(minute, awayTally.goals-homeTally.goals)...)
+			homeTally.results = append(homeTally.results, homeSide.changeTactics(minute, homeTally.goals-awayTally.goals)...)
+			awayTally.results = append(awayTally.results, awaySide.changeTactics(minute, awayTally.goals-homeTally.goals)...)
+			home, away = homeSide.team, awaySide.team
+
+			eventsPerMinute := tempoEvents[gameTempos[homeSide.strategy.GameTempo]+gameTempos[awaySide.strategy.GameTempo]] / 90
+			homeWeight, awayWeight := chanceWeights(
+				homeSide.strategyResult.homeChances+awaySide.strategyResult.awayChances,
+				awaySide.strategyResult.homeChances+homeSide.strategyResult.awayChances,
+			)
+
+			elapsed := clock - period.FirstMinute
+			homeIntent := attackingIntent(homeTally.goals-awayTally.goals, elapsed, period.Minutes)
+			awayIntent := attackingIntent(awayTally.goals-homeTally.goals, elapsed, period.Minutes)
+
+			played := false
+			homeShare := possessionShare(homeSide, awaySide, minute, m.HomeAdvantage, homeIntent-awayIntent)
+			if rng.Float64() < homeShare {
+				homeMinutes++
+				if rng.Float64() < eventsPerMinute*homeWeight*homeIntent*(0.5+0.5*awayIntent) {
+					played = playMinuteEvent(rng, homeEvents, minute, homeSide, awaySide, &homeTally, &awayTally)
+				}
+			} else {
+				awayMinutes++
+				if rng.Float64() < eventsPerMinute*awayWeight*awayIntent*(0.5+0.5*homeIntent) {
+					played = playMinuteEvent(rng, awayEvents, minute, awaySide, homeSide, &awayTally, &homeTally)
+				}
+			}
+
+			if played && rng.Float64() < injuryChancePerEvent {
+				side, results := homeSide, &homeTally.results
+				if rng.Intn(2) == 0 {
+					side, results = awaySide, &awayTally.results
+				}
+				*results = append(*results, injuryDuringMatch(rng, side, minute)...)
+				home, away = homeSide.team, awaySide.team
+			}
+
+			setAddedTime(homeTally.results[homeBefore:], minute, added)
+			setAddedTime(awayTally.results[awayBefore:], minute, added)
+		}
+	}
+
+	periodEnd := period.FirstMinute + period.Minutes
+	homeBefore, awayBefore := len(homeTally.results), len(awayTally.results)
+	homeTally.results = append(homeTally.results, homeSide.plannedChanges(periodEnd, homeTally.goals-awayTally.goals)...)
+	awayTally.results = append(awayTally.results, awaySide.plannedChanges(periodEnd, awayTally.goals-homeTally.goals)...)
+	setAddedTime(homeTally.results[homeBefore:], periodEnd, addedTime[len(addedTime)-1])
+	setAddedTime(awayTally.results[awayBefore:], periodEnd, addedTime[len(addedTime)-1])
+
+	homePossession := int(math.Round(100 * float64(homeMinutes) / float64(max(homeMinutes+awayMinutes, 1))))
+
+	return domain.MatchEventStats{
+		HomeEvents:       homeTally.results,
+		AwayEvents:       awayTally.results,
+		HomeScoreChances: homeTally.chances,
+		AwayScoreChances: awayTally.chances,
+		HomeGoals:        homeTally.goals,
+		AwayGoals:        awayTally.goals,
+		HomeXG:           roundXG(homeTally.xg),
+		AwayXG:           roundXG(awayTally.xg),
+		AddedTime:        addedTime,
+	}, homePossession, 100 - homePossession
+}
+
+func playMinuteEvent(rng *rand.Rand, events []domain.Event, minute int, lineup, rival *matchSide, lineupTally, rivalTally *chainTally) bool {
+	event := events[rng.Intn(len(events))]
+	outcome, err := event.Execute()
+	if err != nil {
+		log.Printf("error executing event %s for team %s: %v", event.Name, lineup.team.Name, err)
+		return false
+	}
+	playChain(rng, event.Name, outcome, minute, lineup, rival, lineupTally, rivalTally)
+	return true
+}
+
+// chanceWeights splits the chances the strategies give each side, 1 each
+// when they are even.
+func chanceWeights(homeFactor, awayFactor float64) (float64, float64) {
+	if homeFactor+awayFactor <= 0 {
+		return 1, 1
+	}
+	return 2 * homeFactor / (homeFactor + awayFactor), 2 * awayFactor / (homeFactor + awayFactor)
+}
+
+// attackingIntent grows as time runs out for a team that is behind and drops
+// for a team that is ahead.
+func attackingIntent(goalDifference, elapsed, length int) float64 {
+	progress := float64(elapsed) / float64(length)
+	switch {
+	case goalDifference < 0:
+		return 1 + chasingIntent*progress
+	case goalDifference > 0:
+		return 1 - protectingIntent*progress
+	}
+	return 1
+}
+
+// possessionShare is the chance the home side has the ball this minute,
+// within the usual 17-83 range.
+func possessionShare(home, away *matchSide, minute int, homeAdvantage, intentDifference float64) float64 {
+	homeStrength := sideStrength(home, minute) * home.strategyResult.homePossession
+	awayStrength := sideStrength(away, minute) * away.strategyResult.homePossession
+	if homeStrength+awayStrength <= 0 {
+		return 0.5
+	}
+	share := homeStrength / (homeStrength + awayStrength)
+	share += float64(homePossessionBonus) / 100 * homeAdvantage
+	share += intentPossessionShift * intentDifference
+	return math.Max(0.17, math.Min(share, 0.83))
+}
+
+// sideStrength adds up the players on the pitch, who tire the longer they
+// play.
+func sideStrength(side *matchSide, minute int) float64 {
+	var strength float64
+	for _, p := range side.team.Players {
+		played := float64(max(minute-side.onSince[p.PlayerId], 0))
+		tiredness := inMatchFatigue * played / 90 * (1 - float64(p.Physique)/200)
+		strength += float64(p.Technique+p.Mental+p.Physique) * math.Max(0, 1-tiredness)
+	}
+	return strength
+}
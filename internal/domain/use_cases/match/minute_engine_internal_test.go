@@ -0,0 +1,47 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttackingIntent(t *testing.T) {
+	assert.Equal(t, 1.0, attackingIntent(0, 80, 90))
+	assert.Equal(t, 1.0, attackingIntent(-1, 0, 90), "nobody chases at kick-off")
+	assert.InDelta(t, 1+chasingIntent, attackingIntent(-1, 90, 90), 1e-9)
+	assert.InDelta(t, 1-protectingIntent, attackingIntent(2, 90, 90), 1e-9)
+	assert.Greater(t, attackingIntent(-1, 80, 90), attackingIntent(-1, 40, 90))
+}
+
+func TestChanceWeights(t *testing.T) {
+	home, away := chanceWeights(1, 1)
+	assert.Equal(t, []float64{1, 1}, []float64{home, away})
+
+	home, away = chanceWeights(3, 1)
+	assert.Equal(t, []float64{1.5, 0.5}, []float64{home, away})
+
+	home, away = chanceWeights(0, 0)
+	assert.Equal(t, []float64{1, 1}, []float64{home, away})
+}
+
+func TestPossessionShare(t *testing.T) {
+	home, away := testSide(), testSide()
+	assert.InDelta(t, 0.5, possessionShare(home, away, 10, 0, 0), 1e-9)
+	assert.InDelta(t, 0.5+homePossessionBonus/100.0, possessionShare(home, away, 10, 1, 0), 1e-9)
+	assert.InDelta(t, 0.5+intentPossessionShift*0.4, possessionShare(home, away, 10, 0, 0.4), 1e-9, "the team chasing the game has more of the ball")
+
+	home.team.Players = home.team.Players[:1]
+	assert.Equal(t, 0.17, possessionShare(home, away, 10, 0, 0))
+}
+
+func TestSideStrengthDropsWithMinutesPlayed(t *testing.T) {
+	side := testSide()
+	fullTime := sideStrength(side, 90)
+
+	assert.Less(t, fullTime, sideStrength(side, 45))
+	assert.Less(t, sideStrength(side, 45), sideStrength(side, 0))
+
+	side.substitute(60, side.team.Players[5], side.team.Bench[2])
+	assert.Greater(t, sideStrength(side, 90), fullTime, "a substitute of the same quality has more left")
+}
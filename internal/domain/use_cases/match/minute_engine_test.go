@@ -0,0 +1,21 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEngine(t *testing.T) {
+	engine, err := match.ParseEngine("")
+	assert.NoError(t, err)
+	assert.Equal(t, match.EngineEventBudget, engine)
+
+	engine, err = match.ParseEngine("minute_by_minute")
+	assert.NoError(t, err)
+	assert.Equal(t, match.EngineMinuteByMinute, engine)
+
+	_, err = match.ParseEngine("turbo")
+	assert.Error(t, err)
+}
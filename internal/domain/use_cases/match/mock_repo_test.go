@@ -33,8 +33,8 @@ func (m *MockMatchRepository) GetPendingMatches(timestamp time.Time) ([]domain.S
 	return args.Get(0).([]domain.SeasonMatch), args.Error(1)
 }
 
-func (m *MockMatchRepository) UpdateMatch(seasonMatch domain.SeasonMatch) error {
-	args := m.Called(seasonMatch.ID, seasonMatch.SeasonID, seasonMatch.HomeTeamID, seasonMatch.AwayTeamID, seasonMatch.MatchDate, seasonMatch.HomeResult, seasonMatch.AwayResult)
+func (m *MockMatchRepository) SaveMatchResult(record domain.MatchRecord) error {
+	args := m.Called(record)
 	return args.Error(0)
 }
 
@@ -47,15 +47,6 @@ func (m *MockTeamRepository) GetTeamByID(teamID uuid.UUID) (domain.Team, error)
 	return args.Get(0).(domain.Team), args.Error(1)
 }
 
-type MockClassificationRepository struct {
-	mock.Mock
-}
-
-func (m *MockClassificationRepository) UpdateClassification(classification domain.Classification) error {
-	args := m.Called(classification)
-	return args.Error(0)
-}
-
 func (m *MockMatchRepository) GetMatchByID(matchID uuid.UUID) (domain.SeasonMatch, error) {
 	args := m.Called(matchID)
 	return args.Get(0).(domain.SeasonMatch), args.Error(1)
@@ -70,3 +61,51 @@ func (m *MockMatchRepository) GetSeasonMatches(seasonID uuid.UUID) ([]domain.Sea
 	args := m.Called(seasonID)
 	return args.Get(0).([]domain.SeasonMatch), args.Error(1)
 }
+
+func (m *MockMatchRepository) GetMatchVenue(matchID uuid.UUID) (domain.Venue, error) {
+	args := m.Called(matchID)
+	return args.Get(0).(domain.Venue), args.Error(1)
+}
+
+func (m *MockMatchRepository) GetMatchTeamStats(matchID uuid.UUID) ([]domain.MatchTeamStats, error) {
+	args := m.Called(matchID)
+	return args.Get(0).([]domain.MatchTeamStats), args.Error(1)
+}
+
+func (m *MockMatchRepository) GetMatchRatings(matchID uuid.UUID) ([]domain.PlayerRating, error) {
+	args := m.Called(matchID)
+	return args.Get(0).([]domain.PlayerRating), args.Error(1)
+}
+
+func (m *MockMatchRepository) GetSeasonRatings(seasonID uuid.UUID) ([]domain.SeasonRating, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).([]domain.SeasonRating), args.Error(1)
+}
+
+type MockCupApp struct {
+	mock.Mock
+}
+
+func (m *MockCupApp) GetCupTie(tieID uuid.UUID) (domain.CupTie, error) {
+	args := m.Called(tieID)
+	return args.Get(0).(domain.CupTie), args.Error(1)
+}
+
+func (m *MockCupApp) GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).([]domain.CupTie), args.Error(1)
+}
+
+func (m *MockCupApp) AdvanceRound(seasonID uuid.UUID) error {
+	args := m.Called(seasonID)
+	return args.Error(0)
+}
+
+type MockLineupApp struct {
+	mock.Mock
+}
+
+func (m *MockLineupApp) MatchLineup(team domain.Team, formation string) (domain.Lineup, error) {
+	args := m.Called(team.Name, formation)
+	return args.Get(0).(domain.Lineup), args.Error(1)
+}
@@ -3,31 +3,65 @@ package match
 import (
 	"fmt"
 	"log"
-	"sort"
+	"math/rand"
 
+	"github.com/google/uuid"
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
-type Simulator struct{}
+type Simulator struct {
+	seed   int64
+	rng    *rand.Rand
+	sides  map[uuid.UUID]*matchSide
+	engine Engine
+}
+
+func NewSimulator(seed int64) Simulator {
+	return Simulator{
+		seed:   seed,
+		rng:    rand.New(rand.NewSource(seed)),
+		sides:  make(map[uuid.UUID]*matchSide),
+		engine: EngineEventBudget,
+	}
+}
+
+func (s Simulator) WithEngine(engine Engine) Simulator {
+	s.engine = engine
+	return s
+}
+
+// side returns the team as it currently stands in this match, so extra time
+// and penalties carry on with the players left on the pitch.
+func (s Simulator) side(team domain.Team) *matchSide {
+	if side, ok := s.sides[team.Id]; ok {
+		return side
+	}
+	side := newMatchSide(team)
+	s.sides[team.Id] = side
+	return side
+}
 
-func NewSimulator() Simulator {
-	return Simulator{}
+func (s Simulator) Seed() int64 {
+	return s.seed
 }
+
 func (s Simulator) Play(m *domain.Match) (domain.Result, []domain.EventResult, error) {
-	homeLineup := m.HomeMatchStrategy.StrategyTeam.Players
+	homeSide := s.side(m.HomeMatchStrategy.StrategyTeam)
+	homeSide.cardLeniency = cardLeniency(m.HomeAdvantage)
+	homeSide.homeAdvantage = m.HomeAdvantage
+	homeTeam := homeSide.team
+	awayTeam := s.side(m.AwayMatchStrategy.StrategyTeam).team
+
+	homeLineup := homeTeam.Players
 	for count, player := range homeLineup {
 		log.Printf("home lineup player #%d: %+v", count, player)
 	}
-	awayLineup := m.AwayMatchStrategy.StrategyTeam.Players
+	awayLineup := awayTeam.Players
 	for count, player := range awayLineup {
 		log.Printf("Away lineup player #%d: %+v", count, player)
 	}
 
-	log.Printf("Home Strategy Team details: %+v", m.HomeMatchStrategy.StrategyTeam)
-	log.Printf("Home Strategy Team Players: %+v", m.HomeMatchStrategy.StrategyTeam.Players)
-
-	homeTeam := m.HomeMatchStrategy.StrategyTeam
-	awayTeam := m.AwayMatchStrategy.StrategyTeam
+	log.Printf("Home Strategy Team details: %+v", homeTeam)
 
 	log.Printf("Rival Lineup (Team %s): %+v", awayTeam.Id, awayLineup)
 
@@ -45,81 +79,123 @@ func (s Simulator) Play(m *domain.Match) (domain.Result, []domain.EventResult, e
 
 		return domain.Result{}, []domain.EventResult{}, fmt.Errorf("error in calculating the result of the awayStrategy AWAY: %w", err)
 	}
-
-	numberOfMatchEvents, err := CalculateNumberOfMatchEvents(m.HomeMatchStrategy.GameTempo, m.AwayMatchStrategy.GameTempo)
-	if err != nil {
-		log.Println("error on numberOfMatchEvents", err)
-		return domain.Result{}, []domain.EventResult{}, err
+	homeSide.useStrategy(homeStrategy, homeResultOfStrategy)
+	s.side(awayTeam).useStrategy(awayStrategy, awayResultOfStrategy)
+
+	var matchEventStats domain.MatchEventStats
+	var lineupPercentagePossession, rivalPercentagePossession int
+	if s.engine == EngineMinuteByMinute {
+		matchEventStats, lineupPercentagePossession, rivalPercentagePossession = s.playByMinute(m, RegularTime)
+	} else {
+		matchEventStats, err = s.playEventBudget(m, homeTeam, awayTeam, homeResultOfStrategy, awayResultOfStrategy)
+		if err != nil {
+			return domain.Result{}, []domain.EventResult{}, err
+		}
 	}
-	log.Println("numberOfMatchEvents", numberOfMatchEvents)
 
-	homeFactorNumberEvents := homeResultOfStrategy.homeChances + awayResultOfStrategy.awayChances
-	awayFactorNumberEvents := awayResultOfStrategy.homeChances + homeResultOfStrategy.awayChances
-
-	numberOfHomeEvents, numberOfAwayEvents, err := DistributeMatchEvents(m.HomeMatchStrategy.StrategyTeam, m.AwayMatchStrategy.StrategyTeam, numberOfMatchEvents, homeFactorNumberEvents, awayFactorNumberEvents)
-	if err != nil {
-		log.Println("error al distribuir numberOfMatchEvents", err)
-		return domain.Result{}, []domain.EventResult{}, err
-	}
-	log.Println("numberOfLineupEvents, numberOfRivalEvents", numberOfHomeEvents, numberOfAwayEvents)
-
-	matchEventStats := GenerateEvents(homeTeam, awayTeam, numberOfHomeEvents, numberOfAwayEvents)
+	allEvents := append(matchEventStats.HomeEvents, matchEventStats.AwayEvents...)
+	halfTime, fullTime := RegularTime.halfEnds()[0], RegularTime.halfEnds()[1]
+	homeHalfTimeGoals, awayHalfTimeGoals := halfTimeGoals(allEvents, homeTeam.Id, halfTime)
 
 	breakMatch := domain.EventResult{
-		Minute:    45,
+		Minute:    halfTime,
+		AddedTime: matchEventStats.AddedTime[0],
 		EventType: string(EventTypeMatchBreak),
-		Event:     "Descanso",
+		Event:     fmt.Sprintf("Descanso %d-%d", homeHalfTimeGoals, awayHalfTimeGoals),
 		TeamId:    homeTeam.Id,
 	}
 
 	endMatch := domain.EventResult{
-		Minute:    90,
+		Minute:    fullTime,
+		AddedTime: matchEventStats.AddedTime[1],
 		EventType: string(EventTypeEndOfTheMatch),
 		Event:     "Final del Partido",
 		TeamId:    homeTeam.Id,
 	}
 
-	allEvents := append(matchEventStats.HomeEvents, matchEventStats.AwayEvents...)
 	allEvents = append(allEvents, breakMatch, endMatch)
-	sort.Slice(allEvents, func(i, j int) bool {
-		return allEvents[i].Minute < allEvents[j].Minute
-	})
-
-	totalHomeTechnique, totalHomeMental, totalHomePhysique := totalStats(homeLineup)
-	totalAwayTechnique, totalAwayMental, totalAwayPhysique := totalStats(awayLineup)
-
-	totalHomePhysique = totalHomePhysique + homeResultOfStrategy.homePhysique
-	totalAwayPhysique = totalAwayPhysique + awayResultOfStrategy.homePhysique
-
-	lineupTotalQuality, rivalTotalQuality, allQuality, err := CalculateTotalQuality(totalHomeTechnique, totalHomeMental, totalHomePhysique, totalAwayTechnique, totalAwayMental, totalAwayPhysique)
-	if err != nil {
-		log.Println("Error calculating total quality:", err)
-		return domain.Result{}, []domain.EventResult{}, err
-	}
-	log.Printf("Total Quality: player %d, rival %d, total quality %d\n", lineupTotalQuality, rivalTotalQuality, allQuality)
+	sortEvents(allEvents)
 
-	lineupPercentagePossession, rivalPercentagePossession, err := CalculateBallPossession(totalHomeTechnique, totalHomeMental, lineupTotalQuality, rivalTotalQuality, allQuality, homeResultOfStrategy.homePossession, awayResultOfStrategy.homePossession)
-	if err != nil {
-		log.Println("Error CalculateBallPossession:", err)
-		return domain.Result{}, []domain.EventResult{}, err
+	if s.engine != EngineMinuteByMinute {
+		lineupPercentagePossession, rivalPercentagePossession, err = s.eventBudgetPossession(m, homeLineup, awayLineup, homeResultOfStrategy, awayResultOfStrategy)
+		if err != nil {
+			return domain.Result{}, []domain.EventResult{}, err
+		}
 	}
 
 	result := domain.Result{
+		Seed: s.seed,
 		HomeStats: domain.TeamStats{
 			BallPossession: lineupPercentagePossession,
 			ScoringChances: matchEventStats.HomeScoreChances,
 			Goals:          matchEventStats.HomeGoals,
+			HalfTimeGoals:  homeHalfTimeGoals,
+			XG:             matchEventStats.HomeXG,
 		},
 		AwayStats: domain.TeamStats{
 			BallPossession: rivalPercentagePossession,
 			ScoringChances: matchEventStats.AwayScoreChances,
 			Goals:          matchEventStats.AwayGoals,
+			HalfTimeGoals:  awayHalfTimeGoals,
+			XG:             matchEventStats.AwayXG,
 		},
 	}
 
+	addEventStats(allEvents, homeTeam.Id, &result.HomeStats, &result.AwayStats)
+
 	return result, allEvents, nil
 }
 
+// playEventBudget decides how many events each side gets from the tempo, the
+// quality of the teams and their strategies, then plays them at random minutes.
+func (s Simulator) playEventBudget(m *domain.Match, homeTeam, awayTeam domain.Team, homeResultOfStrategy, awayResultOfStrategy strategyResult) (domain.MatchEventStats, error) {
+	numberOfMatchEvents, err := CalculateNumberOfMatchEvents(s.rng, m.HomeMatchStrategy.GameTempo, m.AwayMatchStrategy.GameTempo)
+	if err != nil {
+		log.Println("error on numberOfMatchEvents", err)
+		return domain.MatchEventStats{}, err
+	}
+	log.Println("numberOfMatchEvents", numberOfMatchEvents)
+
+	homeFactorNumberEvents := homeResultOfStrategy.homeChances + awayResultOfStrategy.awayChances
+	awayFactorNumberEvents := awayResultOfStrategy.homeChances + homeResultOfStrategy.awayChances
+
+	numberOfHomeEvents, numberOfAwayEvents, err := DistributeMatchEvents(s.rng, homeTeam, awayTeam, numberOfMatchEvents, homeFactorNumberEvents, awayFactorNumberEvents)
+	if err != nil {
+		log.Println("error al distribuir numberOfMatchEvents", err)
+		return domain.MatchEventStats{}, err
+	}
+	numberOfHomeEvents, numberOfAwayEvents = shiftEventsHome(s.rng, numberOfHomeEvents, numberOfAwayEvents, m.HomeAdvantage)
+	log.Println("numberOfLineupEvents, numberOfRivalEvents", numberOfHomeEvents, numberOfAwayEvents)
+
+	return s.GenerateEvents(homeTeam, awayTeam, numberOfHomeEvents, numberOfAwayEvents, RegularTime), nil
+}
+
+// eventBudgetPossession works out possession once the events are played, from
+// what the players gave on the pitch and the strategies.
+func (s Simulator) eventBudgetPossession(m *domain.Match, homeLineup, awayLineup []domain.Player, homeResultOfStrategy, awayResultOfStrategy strategyResult) (int, int, error) {
+	totalHomeTechnique, totalHomeMental, totalHomePhysique := s.side(m.HomeMatchStrategy.StrategyTeam).playedStats(homeLineup, RegularTime)
+	totalAwayTechnique, totalAwayMental, totalAwayPhysique := s.side(m.AwayMatchStrategy.StrategyTeam).playedStats(awayLineup, RegularTime)
+
+	totalHomePhysique = totalHomePhysique + homeResultOfStrategy.homePhysique
+	totalAwayPhysique = totalAwayPhysique + awayResultOfStrategy.homePhysique
+
+	lineupTotalQuality, rivalTotalQuality, allQuality, err := CalculateTotalQuality(totalHomeTechnique, totalHomeMental, totalHomePhysique, totalAwayTechnique, totalAwayMental, totalAwayPhysique)
+	if err != nil {
+		log.Println("Error calculating total quality:", err)
+		return 0, 0, err
+	}
+	log.Printf("Total Quality: player %d, rival %d, total quality %d\n", lineupTotalQuality, rivalTotalQuality, allQuality)
+
+	lineupPercentagePossession, rivalPercentagePossession, err := CalculateBallPossession(s.rng, totalHomeTechnique, totalHomeMental, lineupTotalQuality, rivalTotalQuality, allQuality, homeResultOfStrategy.homePossession, awayResultOfStrategy.homePossession)
+	if err != nil {
+		log.Println("Error CalculateBallPossession:", err)
+		return 0, 0, err
+	}
+
+	lineupPercentagePossession, rivalPercentagePossession = shiftPossessionHome(lineupPercentagePossession, rivalPercentagePossession, m.HomeAdvantage)
+	return lineupPercentagePossession, rivalPercentagePossession, nil
+}
+
 func totalStats(players []domain.Player) (technique, mental, physique int) {
 	for _, p := range players {
 		technique += p.Technique
@@ -0,0 +1,115 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (a AppService) playCupTie(simulator Simulator, m *domain.Match, seasonMatch *domain.SeasonMatch, result *domain.Result) (*domain.CupTie, []domain.EventResult, error) {
+	tie, err := a.cupApp.GetCupTie(*seasonMatch.CupTieID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving cup tie: %w", err)
+	}
+	if tie.TwoLegged && seasonMatch.Leg != 2 {
+		return nil, nil, nil
+	}
+
+	homeAggregate, awayAggregate := result.HomeStats.Goals, result.AwayStats.Goals
+	if tie.TwoLegged {
+		firstLeg, ok := findLeg(tie, 1)
+		if !ok || firstLeg.HomeResult == nil || firstLeg.AwayResult == nil {
+			return nil, nil, fmt.Errorf("first leg of cup tie %s has not been played", tie.ID)
+		}
+		homeAggregate += *firstLeg.AwayResult
+		awayAggregate += *firstLeg.HomeResult
+	}
+
+	var events []domain.EventResult
+	if homeAggregate == awayAggregate {
+		stats, extraTimeEvents, err := simulator.PlayExtraTime(m)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error playing extra time: %w", err)
+		}
+		result.HomeStats.Goals += stats.HomeGoals
+		result.AwayStats.Goals += stats.AwayGoals
+		result.HomeStats.ScoringChances += stats.HomeScoreChances
+		result.AwayStats.ScoringChances += stats.AwayScoreChances
+		result.HomeStats.XG = roundXG(result.HomeStats.XG + stats.HomeXG)
+		result.AwayStats.XG = roundXG(result.AwayStats.XG + stats.AwayXG)
+		homeAggregate += stats.HomeGoals
+		awayAggregate += stats.AwayGoals
+		seasonMatch.ExtraTime = true
+		addEventStats(extraTimeEvents, seasonMatch.HomeTeamID, &result.HomeStats, &result.AwayStats)
+		events = append(events, extraTimeEvents...)
+	}
+
+	if homeAggregate == awayAggregate {
+		homePenalties, awayPenalties, shootoutEvents, err := simulator.PlayPenaltyShootout(m)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error playing penalty shootout: %w", err)
+		}
+		seasonMatch.HomePenalties = &homePenalties
+		seasonMatch.AwayPenalties = &awayPenalties
+		homeAggregate += homePenalties
+		awayAggregate += awayPenalties
+		events = append(events, shootoutEvents...)
+	}
+
+	winner := seasonMatch.AwayTeamID
+	if homeAggregate > awayAggregate {
+		winner = seasonMatch.HomeTeamID
+	}
+	tie.WinnerTeamID = &winner
+
+	return &tie, events, nil
+}
+
+func findLeg(tie domain.CupTie, leg int) (domain.SeasonMatch, bool) {
+	for _, m := range tie.Matches {
+		if m.Leg == leg {
+			return m, true
+		}
+	}
+	return domain.SeasonMatch{}, false
+}
+
+// homeAdvantage returns the home edge of the match: the tournament's, or none
+// when it is a single-legged cup final played on neutral ground.
+func (a AppService) homeAdvantage(storedMatch domain.SeasonMatch) (float64, error) {
+	venue, err := a.matchRepo.GetMatchVenue(storedMatch.ID)
+	if err != nil {
+		return 0, err
+	}
+	if storedMatch.CupTieID == nil || !venue.NeutralFinal {
+		return venue.HomeAdvantage, nil
+	}
+
+	ties, err := a.cupApp.GetCupTies(storedMatch.SeasonID)
+	if err != nil {
+		return 0, fmt.Errorf("error retrieving cup ties: %w", err)
+	}
+	if isSingleLeggedFinal(ties, *storedMatch.CupTieID) {
+		return 0, nil
+	}
+	return venue.HomeAdvantage, nil
+}
+
+func isSingleLeggedFinal(ties []domain.CupTie, tieID uuid.UUID) bool {
+	var tie *domain.CupTie
+	for i := range ties {
+		if ties[i].ID == tieID {
+			tie = &ties[i]
+		}
+	}
+	if tie == nil || tie.TwoLegged {
+		return false
+	}
+	for _, other := range ties {
+		if other.Round == tie.Round && other.ID != tie.ID {
+			return false
+		}
+	}
+	return true
+}
@@ -9,7 +9,20 @@ import (
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
-func (a AppService) PlayMatch(seasonID, matchID uuid.UUID) (domain.Result, error) {
+func (a AppService) PlayMatch(seasonID, matchID uuid.UUID, seed *int64) (domain.Result, error) {
+	storedMatch, err := a.matchRepo.GetMatchByID(matchID)
+	if err != nil {
+		return domain.Result{}, fmt.Errorf("error retrieving match: %w", err)
+	}
+	if seasonID == uuid.Nil {
+		seasonID = storedMatch.SeasonID
+	} else if seasonID != storedMatch.SeasonID {
+		return domain.Result{}, fmt.Errorf("match %s does not belong to season %s", matchID, seasonID)
+	}
+	if storedMatch.HomeResult != nil || storedMatch.AwayResult != nil {
+		return domain.Result{}, fmt.Errorf("match %s: %w", matchID, domain.ErrMatchAlreadyPlayed)
+	}
+
 	m, err := a.matchRepo.GetMatchStrategyById(matchID)
 	if err != nil {
 		return domain.Result{}, fmt.Errorf("error retrieving match: %w", err)
@@ -18,12 +31,34 @@ func (a AppService) PlayMatch(seasonID, matchID uuid.UUID) (domain.Result, error
 		log.Printf("repo.GetMatchStrategyById returned nil for matchID: %s", matchID)
 		return domain.Result{}, fmt.Errorf("no match found with ID: %s", matchID)
 	}
-	result, allEvents, err := a.simulator.Play(m)
+	for _, strategy := range []*domain.Strategy{&m.HomeMatchStrategy, &m.AwayMatchStrategy} {
+		lineup, err := a.lineupApp.MatchLineup(strategy.StrategyTeam, strategy.Formation)
+		if err != nil {
+			return domain.Result{}, fmt.Errorf("error selecting lineup for team %s: %w", strategy.StrategyTeam.Name, err)
+		}
+		strategy.StrategyTeam.Players = lineup.Starters
+		strategy.StrategyTeam.Bench = lineup.Bench
+		strategy.Formation = lineup.Formation
+	}
+	m.HomeAdvantage, err = a.homeAdvantage(storedMatch)
+	if err != nil {
+		return domain.Result{}, err
+	}
+	matchSeed := time.Now().UnixNano()
+	if seed != nil {
+		matchSeed = *seed
+	}
+	simulator := NewSimulator(matchSeed)
+	if a.engine != "" {
+		simulator = simulator.WithEngine(a.engine)
+	}
+	log.Printf("Playing match %s with seed %d", matchID, simulator.Seed())
+
+	result, allEvents, err := simulator.Play(m)
 	if err != nil {
 		return domain.Result{}, fmt.Errorf("error playing match: %w", err)
 	}
 
-	matchDate := time.Now()
 	homeTeamId := m.HomeMatchStrategy.StrategyTeam.Id
 	awayTeamId := m.AwayMatchStrategy.StrategyTeam.Id
 
@@ -34,37 +69,73 @@ func (a AppService) PlayMatch(seasonID, matchID uuid.UUID) (domain.Result, error
 	seasonMatch.SeasonID = seasonID
 	seasonMatch.HomeTeamID = homeTeamId
 	seasonMatch.AwayTeamID = awayTeamId
-	seasonMatch.MatchDate = matchDate
-	seasonMatch.HomeResult = &result.HomeStats.Goals
-	seasonMatch.AwayResult = &result.AwayStats.Goals
+	seasonMatch.MatchDate = storedMatch.MatchDate
+	seasonMatch.Seed = &matchSeed
+	seasonMatch.CupTieID = storedMatch.CupTieID
+	seasonMatch.Leg = storedMatch.Leg
 
-	log.Printf("Calling UpdateMatch with HomeResult=%v, AwayResult=%v", seasonMatch.HomeResult, seasonMatch.AwayResult)
-	err = a.matchRepo.UpdateMatch(seasonMatch)
-	if err != nil {
-		return domain.Result{}, fmt.Errorf("error UpdateMatch: %w", err)
+	var cupTie *domain.CupTie
+	var classifications []domain.Classification
+	if storedMatch.CupTieID != nil {
+		var cupEvents []domain.EventResult
+		cupTie, cupEvents, err = a.playCupTie(simulator, m, &seasonMatch, &result)
+		if err != nil {
+			return domain.Result{}, err
+		}
+		allEvents = append(allEvents, cupEvents...)
+	} else {
+		homeClassification, awayClassification := NewMatchClassifications(seasonID, homeTeamId, awayTeamId, result.HomeStats.Goals, result.AwayStats.Goals)
+		classifications = []domain.Classification{homeClassification, awayClassification}
 	}
 
+	seasonMatch.HomeResult = &result.HomeStats.Goals
+	seasonMatch.AwayResult = &result.AwayStats.Goals
+	seasonMatch.HomeHalfTime = &result.HomeStats.HalfTimeGoals
+	seasonMatch.AwayHalfTime = &result.AwayStats.HalfTimeGoals
+	seasonMatch.HomeXG = &result.HomeStats.XG
+	seasonMatch.AwayXG = &result.AwayStats.XG
+
+	events := make([]domain.MatchEventInfo, 0, len(allEvents))
 	for _, event := range allEvents {
+		events = append(events, domain.MatchEventInfo{
+			MatchID:           matchID,
+			TeamId:            event.TeamId,
+			EventType:         event.EventType,
+			Minute:            event.Minute,
+			AddedTime:         event.AddedTime,
+			Description:       event.Event,
+			XG:                event.XG,
+			PlayerID:          event.PlayerID,
+			SecondaryPlayerID: event.SecondaryPlayerID,
+			OpponentPlayerID:  event.OpponentPlayerID,
+			Outcome:           event.Outcome,
+		})
+	}
 
-		matchEventInfo := domain.MatchEventInfo{
-			MatchID:     matchID,
-			TeamId:      event.TeamId,
-			EventType:   event.EventType,
-			Minute:      event.Minute,
-			Description: event.Event,
-		}
+	appearances := simulator.Appearances()
 
-		err = a.matchRepo.PostMatchEvent(matchEventInfo)
-		if err != nil {
-			log.Printf("error posting event to repo: %v", err)
-			return domain.Result{}, fmt.Errorf("PostMatchEvent failed: %w", err)
-		}
+	log.Printf("Saving match result HomeResult=%v, AwayResult=%v", *seasonMatch.HomeResult, *seasonMatch.AwayResult)
+	err = a.matchRepo.SaveMatchResult(domain.MatchRecord{
+		Match:           seasonMatch,
+		Events:          events,
+		Classifications: classifications,
+		CupTie:          cupTie,
+		Discipline:      PlayerDisciplines(simulator.Bookings()),
+		Injuries:        simulator.Injuries(),
+		Appearances:     appearances,
+		TeamStats:       matchTeamStats(matchID, homeTeamId, awayTeamId, result),
+		Ratings:         PlayerRatings(matchID, homeTeamId, appearances, allEvents, result),
+	})
+	if err != nil {
+		return domain.Result{}, fmt.Errorf("SaveMatchResult failed: %w", err)
 	}
 
-	err = a.UpdateClassification(homeTeamId, awayTeamId, result.HomeStats.Goals, result.AwayStats.Goals)
-	if err != nil {
-		log.Printf("error posting event to repo: %v", err)
-		return domain.Result{}, fmt.Errorf("UpdateClassification failed: %w", err)
+	// The result is already saved, so a failed draw is left for the
+	// scheduler, which draws every decided round on each run.
+	if cupTie != nil {
+		if err := a.cupApp.AdvanceRound(seasonID); err != nil {
+			log.Printf("AdvanceRound failed for season %s: %v", seasonID, err)
+		}
 	}
 
 	return result, nil
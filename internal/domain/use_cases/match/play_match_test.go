@@ -15,11 +15,8 @@ func TestPlayMatch(t *testing.T) {
 	seasonID := uuid.New()
 
 	mockRepo := new(MockMatchRepository)
-	mockClassificationRepo := new(MockClassificationRepository)
 	mockTeamRepo := new(MockTeamRepository)
 
-	mockClassificationRepo.On("UpdateClassification", mock.Anything).Return(nil)
-
 	homePlayers := []domain.Player{
 		{PlayerId: uuid.New(), FirstName: "Marc-André", LastName: "ter Stegen", Nationality: "DEU", Position: "goalkeeper", Age: 31, Fee: 50000000, Salary: 10000000, Technique: 85, Mental: 88, Physique: 80, InjuryDays: 0, Lined: true, Familiarity: 90, Fitness: 95, Happiness: 90},
 		{PlayerId: uuid.New(), FirstName: "Jules", LastName: "Koundé", Nationality: "FRA", Position: "defender", Age: 25, Fee: 60000000, Salary: 9000000, Technique: 78, Mental: 85, Physique: 88, InjuryDays: 0, Lined: true, Familiarity: 85, Fitness: 92, Happiness: 87},
@@ -77,21 +74,18 @@ func TestPlayMatch(t *testing.T) {
 		AwayMatchStrategy: awayStrategy,
 	}
 
+	mockRepo.On("GetMatchByID", matchID).Return(domain.SeasonMatch{ID: matchID, SeasonID: seasonID}, nil)
 	mockRepo.On("GetMatchStrategyById", matchID).Return(&game, nil)
-	mockRepo.On("PostMatchEvent", mock.Anything).Return(nil)
-	mockRepo.On("PostMatches", mock.Anything).Return(nil)
-	mockRepo.On(
-		"UpdateMatch",
-		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything, mock.Anything,
-	).Return(nil)
+	mockRepo.On("GetMatchVenue", matchID).Return(domain.Venue{HomeAdvantage: domain.DefaultHomeAdvantage}, nil)
+	mockRepo.On("SaveMatchResult", mock.Anything).Return(nil)
 
-	mockTeamRepo.On("GetTeamByID", homeTeam.Id).Return(homeTeam, nil)
-	mockTeamRepo.On("GetTeamByID", awayTeam.Id).Return(awayTeam, nil)
+	mockLineupApp := new(MockLineupApp)
+	mockLineupApp.On("MatchLineup", homeTeam.Name, "4-4-2").Return(domain.Lineup{Formation: "4-4-2", Starters: homePlayers}, nil)
+	mockLineupApp.On("MatchLineup", awayTeam.Name, "4-4-2").Return(domain.Lineup{Formation: "4-4-2", Starters: awayPlayers}, nil)
 
-	service := match.NewApp(mockRepo, mockClassificationRepo, mockTeamRepo)
+	service := match.NewApp(mockRepo, mockTeamRepo, new(MockCupApp), mockLineupApp)
 
-	result, err := service.PlayMatch(seasonID, matchID)
+	result, err := service.PlayMatch(seasonID, matchID, nil)
 
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
@@ -108,6 +102,27 @@ func TestPlayMatch(t *testing.T) {
 	}
 
 	mockRepo.AssertExpectations(t)
-	mockClassificationRepo.AssertExpectations(t)
-	mockTeamRepo.AssertExpectations(t)
+}
+
+func TestPlayMatchRejectsAlreadyPlayedMatch(t *testing.T) {
+	matchID := uuid.New()
+	seasonID := uuid.New()
+	homeGoals, awayGoals := 2, 1
+
+	mockRepo := new(MockMatchRepository)
+	mockRepo.On("GetMatchByID", matchID).Return(domain.SeasonMatch{
+		ID:         matchID,
+		SeasonID:   seasonID,
+		HomeResult: &homeGoals,
+		AwayResult: &awayGoals,
+	}, nil)
+
+	service := match.NewApp(mockRepo, new(MockTeamRepository), new(MockCupApp), new(MockLineupApp))
+
+	_, err := service.PlayMatch(seasonID, matchID, nil)
+
+	assert.ErrorIs(t, err, domain.ErrMatchAlreadyPlayed)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetMatchStrategyById", matchID)
+	mockRepo.AssertNotCalled(t, "SaveMatchResult", mock.Anything)
 }
@@ -0,0 +1,219 @@
+package match_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTeam(name string, quality int) domain.Team {
+	positions := []string{
+		domain.PositionGoalkeeper,
+		domain.PositionDefender, domain.PositionDefender, domain.PositionDefender, domain.PositionDefender,
+		domain.PositionMidfielder, domain.PositionMidfielder, domain.PositionMidfielder,
+		domain.PositionForward, domain.PositionForward, domain.PositionForward,
+	}
+
+	team := domain.Team{Id: uuid.New(), Name: name, Country: "ESP"}
+	for i, position := range positions {
+		team.Players = append(team.Players, domain.Player{
+			PlayerId:    uuid.New(),
+			FirstName:   name,
+			LastName:    position,
+			Position:    position,
+			Technique:   quality + i%3,
+			Mental:      quality - i%2,
+			Physique:    quality + i%4,
+			Lined:       true,
+			Familiarity: 85,
+			Fitness:     90,
+			Happiness:   85,
+		})
+	}
+	return team
+}
+
+func newTestMatch() *domain.Match {
+	return &domain.Match{
+		HomeMatchStrategy: domain.Strategy{
+			StrategyTeam:         newTestTeam("Home", 82),
+			Formation:            "4-3-3",
+			PlayingStyle:         "possession",
+			GameTempo:            "fast_tempo",
+			PassingStyle:         "short",
+			DefensivePositioning: "zonal_marking",
+			BuildUpPlay:          "play_from_back",
+			AttackFocus:          "wide_play",
+			KeyPlayerUsage:       "reference_player",
+		},
+		AwayMatchStrategy: domain.Strategy{
+			StrategyTeam:         newTestTeam("Away", 78),
+			Formation:            "4-3-3",
+			PlayingStyle:         "counter_attack",
+			GameTempo:            "fast_tempo",
+			PassingStyle:         "long",
+			DefensivePositioning: "man_marking",
+			BuildUpPlay:          "long_clearance",
+			AttackFocus:          "central_play",
+			KeyPlayerUsage:       "free_role_player",
+		},
+	}
+}
+
+func TestSimulatorPlayIsReproducibleWithSeed(t *testing.T) {
+	m := newTestMatch()
+
+	firstResult, firstEvents, err := match.NewSimulator(42).Play(m)
+	assert.NoError(t, err)
+
+	secondResult, secondEvents, err := match.NewSimulator(42).Play(m)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(42), firstResult.Seed)
+	assert.Equal(t, firstResult, secondResult)
+	assert.Equal(t, firstEvents, secondEvents)
+}
+
+// TestSimulatorSmoke plays a few matches with both engines and checks what
+// must hold whatever happens on the pitch. The rules themselves are tested
+// one by one next to them.
+func TestSimulatorSmoke(t *testing.T) {
+	for _, engine := range []match.Engine{match.EngineEventBudget, match.EngineMinuteByMinute} {
+		for seed := int64(1); seed <= 10; seed++ {
+			m := newTestMatch()
+			m.HomeMatchStrategy.StrategyTeam = withBench(m.HomeMatchStrategy.StrategyTeam, 75)
+			m.AwayMatchStrategy.StrategyTeam = withBench(m.AwayMatchStrategy.StrategyTeam, 75)
+			m.HomeMatchStrategy.TacticalChanges = []domain.TacticalChange{{
+				Score:        domain.ScoreDrawing,
+				FromMinute:   30,
+				PlayingStyle: "high_press",
+				GameTempo:    "slow_tempo",
+			}}
+
+			players := map[uuid.UUID]map[uuid.UUID]bool{
+				m.HomeMatchStrategy.StrategyTeam.Id: teamPlayers(m.HomeMatchStrategy.StrategyTeam),
+				m.AwayMatchStrategy.StrategyTeam.Id: teamPlayers(m.AwayMatchStrategy.StrategyTeam),
+			}
+			msg := fmt.Sprintf("%s seed %d", engine, seed)
+			simulator := match.NewSimulator(seed).WithEngine(engine)
+			result, events, err := simulator.Play(m)
+			if !assert.NoError(t, err, msg) {
+				continue
+			}
+
+			substitutions := map[uuid.UUID]int{}
+			windows := map[uuid.UUID]map[int]bool{}
+			goals, halfTimeGoals := map[uuid.UUID]int{}, map[uuid.UUID]int{}
+			xg := map[uuid.UUID]float64{}
+			cards, injuries, tacticalChanges := 0, 0, 0
+			yellowCards := map[uuid.UUID]int{}
+			var halfTime domain.EventResult
+			for i, event := range events {
+				if i > 0 {
+					previous := events[i-1]
+					assert.True(t, previous.Minute < event.Minute || previous.Minute == event.Minute && previous.AddedTime <= event.AddedTime, "%s: events out of order", msg)
+				}
+				assert.GreaterOrEqual(t, event.XG, 0.0, msg)
+				assert.Less(t, event.XG, 1.0, msg)
+				xg[event.TeamId] += event.XG
+				if event.AddedTime > 0 {
+					assert.Contains(t, []int{45, 90}, event.Minute, msg)
+				}
+				if event.Outcome == domain.OutcomeGoal {
+					goals[event.TeamId]++
+					if event.Minute <= 45 {
+						halfTimeGoals[event.TeamId]++
+					}
+					if assert.NotNil(t, event.PlayerID, "%s: goal without scorer", msg) {
+						assert.True(t, players[event.TeamId][*event.PlayerID], "%s: scorer is not from the scoring team", msg)
+					}
+				}
+				switch event.EventType {
+				case string(match.EventTypeSubstitution):
+					substitutions[event.TeamId]++
+					if windows[event.TeamId] == nil {
+						windows[event.TeamId] = map[int]bool{}
+					}
+					windows[event.TeamId][event.Minute] = true
+				case string(match.EventTypeYellowCard):
+					cards++
+					yellowCards[event.TeamId]++
+				case string(match.EventTypeRedCard):
+					cards++
+				case string(match.EventTypeInjuryDuringMatch):
+					injuries++
+				case string(match.EventTypeTacticalChange):
+					tacticalChanges++
+				case string(match.EventTypeMatchBreak):
+					halfTime = event
+				}
+			}
+			assert.Equal(t, 100, result.HomeStats.BallPossession+result.AwayStats.BallPossession, msg)
+			assert.Equal(t, string(match.EventTypeEndOfTheMatch), events[len(events)-1].EventType, msg)
+			assert.Equal(t, 90, events[len(events)-1].Minute, msg)
+			assert.Positive(t, events[len(events)-1].AddedTime, msg)
+			assert.Equal(t, 45, halfTime.Minute, msg)
+			assert.Positive(t, halfTime.AddedTime, msg)
+			assert.Equal(t, halfTimeGoals[m.HomeMatchStrategy.StrategyTeam.Id], result.HomeStats.HalfTimeGoals, msg)
+			assert.Equal(t, halfTimeGoals[m.AwayMatchStrategy.StrategyTeam.Id], result.AwayStats.HalfTimeGoals, msg)
+			assert.InDelta(t, xg[m.HomeMatchStrategy.StrategyTeam.Id], result.HomeStats.XG, 0.01, msg)
+			assert.InDelta(t, xg[m.AwayMatchStrategy.StrategyTeam.Id], result.AwayStats.XG, 0.01, msg)
+			assert.Equal(t, yellowCards[m.HomeMatchStrategy.StrategyTeam.Id], result.HomeStats.YellowCards, msg)
+			assert.Equal(t, yellowCards[m.AwayMatchStrategy.StrategyTeam.Id], result.AwayStats.YellowCards, msg)
+			for _, stats := range []struct{ team, rival domain.TeamStats }{
+				{result.HomeStats, result.AwayStats},
+				{result.AwayStats, result.HomeStats},
+			} {
+				assert.LessOrEqual(t, stats.team.Goals, stats.team.ShotsOnTarget, msg)
+				assert.LessOrEqual(t, stats.team.ShotsOnTarget, stats.team.Shots, msg)
+				assert.Equal(t, stats.rival.ShotsOnTarget-stats.rival.Goals, stats.team.Saves, msg)
+			}
+			assert.Equal(t, result.HomeStats.Goals, goals[m.HomeMatchStrategy.StrategyTeam.Id], msg)
+			assert.Equal(t, result.AwayStats.Goals, goals[m.AwayMatchStrategy.StrategyTeam.Id], msg)
+			assert.LessOrEqual(t, tacticalChanges, 1, msg)
+			for teamID, count := range substitutions {
+				assert.LessOrEqual(t, count, 5, msg)
+				assert.LessOrEqual(t, len(windows[teamID]), 3, msg)
+			}
+
+			sentOff := map[uuid.UUID]bool{}
+			for _, booking := range simulator.Bookings() {
+				assert.False(t, sentOff[booking.PlayerID], "%s: player booked after being sent off", msg)
+				if booking.Card == domain.CardRed {
+					sentOff[booking.PlayerID] = true
+				}
+				if booking.SecondYellow {
+					cards--
+				}
+				cards--
+			}
+			assert.Zero(t, cards, "%s: every card is booked", msg)
+			assert.Len(t, simulator.Injuries(), injuries, msg)
+
+			appearances := simulator.Appearances()
+			played := map[uuid.UUID]int{}
+			for _, appearance := range appearances {
+				assert.GreaterOrEqual(t, appearance.Minutes, 0, msg)
+				assert.LessOrEqual(t, appearance.Minutes, 90, msg)
+				played[appearance.TeamID] += appearance.Minutes
+			}
+			for _, minutes := range played {
+				assert.LessOrEqual(t, minutes, 11*90, msg)
+			}
+
+			ratings := match.PlayerRatings(uuid.New(), m.HomeMatchStrategy.StrategyTeam.Id, appearances, events, result)
+			assert.Len(t, ratings, len(appearances), msg)
+			manOfTheMatch := 0
+			for _, rating := range ratings {
+				if rating.ManOfTheMatch {
+					manOfTheMatch++
+				}
+			}
+			assert.Equal(t, 1, manOfTheMatch, msg)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package match
+
+import (
+	"math"
+	"sort"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const (
+	fitnessPhysiqueImpact    = 0.3
+	fitnessTechniqueImpact   = 0.1
+	familiarityMentalImpact  = 0.15
+	happinessImpact          = 0.1
+	fitnessLossPerFullMatch  = 30
+	fitnessLossPhysiqueShare = 10
+)
+
+// EffectivePlayer returns the player with technique, mental and physique as
+// they play today: tired players lose physique and some technique, players
+// unfamiliar with the team's tactics lose mental sharpness and happy players
+// play a little better. Unknown values (0) leave the attributes untouched.
+func EffectivePlayer(p domain.Player) domain.Player {
+	technique, mental, physique := float64(p.Technique), float64(p.Mental), float64(p.Physique)
+
+	if p.Fitness > 0 {
+		fitness := float64(p.Fitness) / 100
+		physique *= 1 - fitnessPhysiqueImpact*(1-fitness)
+		technique *= 1 - fitnessTechniqueImpact*(1-fitness)
+	}
+	if p.Familiarity > 0 {
+		mental *= 1 - familiarityMentalImpact*(1-float64(p.Familiarity)/100)
+	}
+	if p.Happiness > 0 {
+		mood := 1 + happinessImpact*(float64(p.Happiness)/100-0.5)
+		technique *= mood
+		mental *= mood
+	}
+
+	p.Technique = int(math.Round(technique))
+	p.Mental = int(math.Round(mental))
+	p.Physique = int(math.Round(physique))
+	return p
+}
+
+func effectivePlayers(players []domain.Player) []domain.Player {
+	result := make([]domain.Player, 0, len(players))
+	for _, p := range players {
+		result = append(result, EffectivePlayer(p))
+	}
+	return result
+}
+
+// FitnessLoss is the fitness a player spends in the given minutes. A strong
+// physique makes a full match cost less.
+func FitnessLoss(p domain.Player, minutes int) int {
+	perMatch := fitnessLossPerFullMatch - p.Physique/fitnessLossPhysiqueShare
+	return perMatch * minutes / 90
+}
+
+// Appearances returns the minutes every player who took part in the match
+// spent on the pitch and the fitness they cost.
+func (s Simulator) Appearances() []domain.Appearance {
+	var appearances []domain.Appearance
+	for _, side := range s.sides {
+		appearances = append(appearances, side.appearances()...)
+	}
+	sort.SliceStable(appearances, func(i, j int) bool {
+		return appearances[i].TeamID.String() < appearances[j].TeamID.String()
+	})
+	return appearances
+}
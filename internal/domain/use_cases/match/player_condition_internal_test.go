@@ -0,0 +1,43 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchSidePlaysWithEffectivePlayers(t *testing.T) {
+	tired := testPlayer(domain.PositionMidfielder, 80)
+	tired.Fitness = 20
+	team := domain.Team{Id: uuid.New(), Players: []domain.Player{tired}}
+
+	side := newMatchSide(team)
+
+	assert.Equal(t, EffectivePlayer(tired), side.team.Players[0])
+	assert.Less(t, side.team.Players[0].Physique, tired.Physique)
+	assert.Equal(t, tired, side.squad[tired.PlayerId], "the squad keeps the player as given")
+}
+
+func TestAppearances(t *testing.T) {
+	side := testSide()
+	side.startPeriod(RegularTime)
+	players, bench := side.team.Players, side.team.Bench
+	keeper, substituted, substitute, sentOff := players[0], players[5], bench[2], players[9]
+
+	side.substitute(60, substituted, substitute)
+	side.book(70, sentOff, domain.CardRed)
+
+	minutes := map[uuid.UUID]int{}
+	for _, appearance := range side.appearances() {
+		assert.Equal(t, side.team.Id, appearance.TeamID)
+		assert.Equal(t, FitnessLoss(side.squad[appearance.PlayerID], appearance.Minutes), appearance.FitnessLoss)
+		minutes[appearance.PlayerID] = appearance.Minutes
+	}
+	assert.Len(t, minutes, 12, "unused substitutes do not appear")
+	assert.Equal(t, 90, minutes[keeper.PlayerId])
+	assert.Equal(t, 60, minutes[substituted.PlayerId])
+	assert.Equal(t, 30, minutes[substitute.PlayerId])
+	assert.Equal(t, 70, minutes[sentOff.PlayerId])
+}
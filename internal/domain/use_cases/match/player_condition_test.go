@@ -0,0 +1,44 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectivePlayer(t *testing.T) {
+	base := domain.Player{Technique: 80, Mental: 80, Physique: 80}
+
+	tests := map[string]struct {
+		fitness, familiarity, happiness int
+		technique, mental, physique     int
+	}{
+		"unknown condition":  {0, 0, 0, 80, 80, 80},
+		"fresh and settled":  {100, 100, 50, 80, 80, 80},
+		"exhausted":          {20, 100, 50, 74, 80, 61},
+		"new to the tactics": {100, 20, 50, 80, 70, 80},
+		"unhappy":            {100, 100, 1, 76, 76, 80},
+		"delighted":          {100, 100, 100, 84, 84, 80},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := base
+			p.Fitness, p.Familiarity, p.Happiness = tc.fitness, tc.familiarity, tc.happiness
+
+			effective := match.EffectivePlayer(p)
+
+			assert.Equal(t, tc.technique, effective.Technique)
+			assert.Equal(t, tc.mental, effective.Mental)
+			assert.Equal(t, tc.physique, effective.Physique)
+		})
+	}
+}
+
+func TestFitnessLoss(t *testing.T) {
+	assert.Equal(t, 22, match.FitnessLoss(domain.Player{Physique: 80}, 90))
+	assert.Equal(t, 11, match.FitnessLoss(domain.Player{Physique: 80}, 45))
+	assert.Equal(t, 0, match.FitnessLoss(domain.Player{Physique: 80}, 0))
+}
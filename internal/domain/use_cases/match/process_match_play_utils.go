@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
-	"time"
+	"slices"
+	"sort"
 
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
-func CalculateNumberOfMatchEvents(homeGameTempo, awayGameTempo string) (int, error) {
+func CalculateNumberOfMatchEvents(rng *rand.Rand, homeGameTempo, awayGameTempo string) (int, error) {
 
 	var tempoMap = map[string]int{
 		"slow_tempo":     1,
@@ -25,22 +26,22 @@ func CalculateNumberOfMatchEvents(homeGameTempo, awayGameTempo string) (int, err
 
 	switch {
 	case matchTempo <= 2:
-		numberOfMatchEvents = rand.Intn(6) + 3
+		numberOfMatchEvents = rng.Intn(6) + 3
 	case matchTempo > 2 && matchTempo <= 3:
-		numberOfMatchEvents = rand.Intn(8) + 4
+		numberOfMatchEvents = rng.Intn(8) + 4
 	case matchTempo > 3 && matchTempo <= 4:
-		numberOfMatchEvents = rand.Intn(9) + 6
+		numberOfMatchEvents = rng.Intn(9) + 6
 	case matchTempo > 4 && matchTempo <= 5:
-		numberOfMatchEvents = rand.Intn(9) + 9
+		numberOfMatchEvents = rng.Intn(9) + 9
 	case matchTempo > 5 && matchTempo <= 6:
-		numberOfMatchEvents = rand.Intn(11) + 12
+		numberOfMatchEvents = rng.Intn(11) + 12
 	}
 
 	log.Println("numberOfMatchEvents", numberOfMatchEvents)
 	return numberOfMatchEvents, nil
 }
 
-func DistributeMatchEvents(home, away domain.Team, numberOfMatchEvents int, homeFactorNumberEvents, awayFactorNumberEvents float64) (int, int, error) {
+func DistributeMatchEvents(rng *rand.Rand, home, away domain.Team, numberOfMatchEvents int, homeFactorNumberEvents, awayFactorNumberEvents float64) (int, int, error) {
 	const (
 		homeEventMaxBonus       = 3
 		homeEventBaseBonus      = 1
@@ -67,18 +68,18 @@ func DistributeMatchEvents(home, away domain.Team, numberOfMatchEvents int, home
 	var homeEvents int
 	homeProportion := float64(homeTotalQuality) / float64(allQuality)
 
-	homeEvents = int(homeProportion*float64(numberOfMatchEvents)) + rand.Intn(homeEventMaxBonus) + homeEventBaseBonus
+	homeEvents = int(homeProportion*float64(numberOfMatchEvents)) + rng.Intn(homeEventMaxBonus) + homeEventBaseBonus
 
 	log.Printf("number of home events %v BEFORE RANDOMFACTOR", homeEvents)
 
 	homeEvents = homeEvents * int(homeFactorNumberEvents) / int(awayFactorNumberEvents)
 
-	randomFactor := rand.Intn(homeEventRandomRange) - homeEventRandomOffset
+	randomFactor := rng.Intn(homeEventRandomRange) - homeEventRandomOffset
 
 	homeEvents += randomFactor
 
 	if homeEvents > numberOfMatchEvents {
-		homeEvents = numberOfMatchEvents - rand.Intn(homeEventOverflowAdjust)
+		homeEvents = numberOfMatchEvents - rng.Intn(homeEventOverflowAdjust)
 	}
 
 	awayEvents := numberOfMatchEvents - homeEvents
@@ -104,7 +105,7 @@ func CalculateQuality(home domain.Team) (int, error) {
 	return 2*totalTechnique + 3*totalMental + 2*totalPhysique, nil
 }
 
-func GetRandomDefender(home []domain.Player) *domain.Player {
+func GetRandomDefender(rng *rand.Rand, home []domain.Player) *domain.Player {
 	var defenders []domain.Player
 	for _, player := range home {
 		if player.Position == domain.PositionDefender {
@@ -112,10 +113,10 @@ func GetRandomDefender(home []domain.Player) *domain.Player {
 		}
 
 	}
-	return GetRandomPlayer(defenders)
+	return GetRandomPlayer(rng, defenders)
 }
 
-func GetRandomMidfielder(home []domain.Player) *domain.Player {
+func GetRandomMidfielder(rng *rand.Rand, home []domain.Player) *domain.Player {
 	var midfielders []domain.Player
 	for _, player := range home {
 		if player.Position == domain.PositionMidfielder {
@@ -123,10 +124,10 @@ func GetRandomMidfielder(home []domain.Player) *domain.Player {
 		}
 
 	}
-	return GetRandomPlayer(midfielders)
+	return GetRandomPlayer(rng, midfielders)
 }
 
-func GetRandomForward(home []domain.Player) *domain.Player {
+func GetRandomForward(rng *rand.Rand, home []domain.Player) *domain.Player {
 	var forwards []domain.Player
 	for _, player := range home {
 		if player.Position == domain.PositionForward {
@@ -134,10 +135,10 @@ func GetRandomForward(home []domain.Player) *domain.Player {
 		}
 
 	}
-	return GetRandomPlayer(forwards)
+	return GetRandomPlayer(rng, forwards)
 }
 
-func GetGoalkeeper(home []domain.Player) *domain.Player {
+func GetGoalkeeper(rng *rand.Rand, home []domain.Player) *domain.Player {
 	var goalkeepers []domain.Player
 	for _, player := range home {
 		if player.Position == domain.PositionGoalkeeper {
@@ -145,10 +146,10 @@ func GetGoalkeeper(home []domain.Player) *domain.Player {
 		}
 
 	}
-	return GetRandomPlayer(goalkeepers)
+	return GetRandomPlayer(rng, goalkeepers)
 }
 
-func GetRandomPlayerExcludingGoalkeeper(home []domain.Player) *domain.Player {
+func GetRandomPlayerExcludingGoalkeeper(rng *rand.Rand, home []domain.Player) *domain.Player {
 	var playersExcludingGoalkeepers []domain.Player
 	for _, player := range home {
 		if player.Position != domain.PositionGoalkeeper {
@@ -156,235 +157,222 @@ func GetRandomPlayerExcludingGoalkeeper(home []domain.Player) *domain.Player {
 		}
 
 	}
-	return GetRandomPlayer(playersExcludingGoalkeepers)
+	return GetRandomPlayer(rng, playersExcludingGoalkeepers)
 }
 
-func GetRandomPlayer(filteredPlayers []domain.Player) *domain.Player {
+func GetRandomPlayer(rng *rand.Rand, filteredPlayers []domain.Player) *domain.Player {
 	if len(filteredPlayers) == 0 {
 		return nil
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	randomPlayer := filteredPlayers[rand.Intn(len(filteredPlayers))]
+	randomPlayer := filteredPlayers[rng.Intn(len(filteredPlayers))]
 	return &randomPlayer
 }
 
-func GenerateEvents(home, awayHome domain.Team, numberOfHomeEvents, numberOfAwayEvents int) domain.MatchEventStats {
-
-	homeEvents := []domain.Event{
+// teamEvents are the events a team can play. They read the teams through the
+// pointers, so they always see the players currently on the pitch.
+func teamEvents(rng *rand.Rand, lineup, rival *domain.Team) []domain.Event {
+	return []domain.Event{
 		{
 			string(EventTypeKeyPass),
-			func() (string, int, int, int, int, error) {
-				return KeyPass(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return KeyPass(rng, *lineup, *rival)
 			},
 		},
 		{
 			string(EventTypeShot),
-			func() (string, int, int, int, int, error) {
-				return Shot(home, awayHome, GetRandomForward(home.Players))
+			func() (domain.EventOutcome, error) {
+				return Shot(rng, *lineup, *rival, GetRandomForward(rng, lineup.Players))
 			},
 		},
 		{
 			string(EventTypePenaltyKick),
-			func() (string, int, int, int, int, error) {
-				return PenaltyKick(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return PenaltyKick(rng, *lineup, *rival)
 			},
 		},
 		{
 			string(EventTypeLongShot),
-			func() (string, int, int, int, int, error) {
-				return LongShot(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return LongShot(rng, *lineup, *rival)
 			},
 		},
 		{
 			string(EventTypeIndirectFreeKick),
-			func() (string, int, int, int, int, error) {
-				return IndirectFreeKick(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return IndirectFreeKick(rng, *lineup, *rival)
 			},
 		},
 		{
 			string(EventTypeDribble),
-			func() (string, int, int, int, int, error) {
-				return Dribble(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return Dribble(rng, *lineup, *rival)
 			},
 		},
 		{
 			string(EventTypeFoul),
-			func() (string, int, int, int, int, error) {
-				return Foul(home, awayHome, nil)
+			func() (domain.EventOutcome, error) {
+				return Foul(rng, *lineup, *rival)
 			},
 		},
-
 		{
 			string(EventTypeGreatScoringChance),
-			func() (string, int, int, int, int, error) {
-				return GreatScoringChance(home)
+			func() (domain.EventOutcome, error) {
+				return GreatScoringChance(rng, *lineup)
 			},
 		},
 		{
 			string(EventTypeCornerKick),
-			func() (string, int, int, int, int, error) {
-				return CornerKick(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return CornerKick(rng, *lineup, *rival)
 			},
 		},
 		{
 			string(EventTypeOffside),
-			func() (string, int, int, int, int, error) {
-				return Offside(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return Offside(rng, *lineup, *rival)
 			},
 		},
 		{
 			string(EventTypeHeaded),
-			func() (string, int, int, int, int, error) {
-				return Headed(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return Headed(rng, *lineup, *rival)
 			},
 		}, {
 			string(EventTypeCounterAttack),
-			func() (string, int, int, int, int, error) {
-				return CounterAttack(home, awayHome)
+			func() (domain.EventOutcome, error) {
+				return CounterAttack(rng, *lineup, *rival)
 			},
 		},
 	}
+}
 
-	awayEvents := []domain.Event{
-		{
-			string(EventTypeKeyPass),
-			func() (string, int, int, int, int, error) {
-				return KeyPass(awayHome, home)
-			},
-		},
-		{
-			string(EventTypeShot),
-			func() (string, int, int, int, int, error) {
-				return Shot(awayHome, home, GetRandomForward(awayHome.Players))
-			},
-		},
-		{
-			string(EventTypePenaltyKick),
-			func() (string, int, int, int, int, error) {
-				return PenaltyKick(awayHome, home)
-			},
-		},
-		{
-			string(EventTypeLongShot),
-			func() (string, int, int, int, int, error) {
-				return LongShot(awayHome, home)
-			},
-		},
-		{
-			string(EventTypeIndirectFreeKick),
-			func() (string, int, int, int, int, error) {
-				return IndirectFreeKick(awayHome, home)
-			},
-		},
-		{
-			string(EventTypeDribble),
-			func() (string, int, int, int, int, error) {
-				return Dribble(awayHome, home)
-			},
-		},
-		{
-			string(EventTypeFoul),
-			func() (string, int, int, int, int, error) {
-				return Foul(awayHome, home, nil)
-			},
-		},
-		{
-			string(EventTypeGreatScoringChance),
-			func() (string, int, int, int, int, error) {
-				return GreatScoringChance(awayHome)
-			},
-		},
-		{
-			string(EventTypeCornerKick),
-			func() (string, int, int, int, int, error) {
-				return CornerKick(awayHome, home)
-			},
-		},
-		{
-			string(EventTypeOffside),
-			func() (string, int, int, int, int, error) {
-				return Offside(awayHome, home)
-			},
-		},
-		{
-			string(EventTypeHeaded),
-			func() (string, int, int, int, int, error) {
-				return Headed(awayHome, home)
-			},
-		}, {
-			string(EventTypeCounterAttack),
-			func() (string, int, int, int, int, error) {
-				return CounterAttack(awayHome, home)
-			},
-		},
-	}
-	var homeResults []domain.EventResult
-	var awayResults []domain.EventResult
-	var homeChances, awayChances, homeGoals, awayGoals int
+type scheduledEvent struct {
+	minute    int
+	addedTime int
+	home      bool
+}
 
+// GenerateEvents plays the events of a period in chronological order, so
+// injuries and substitutions change who takes part in the events after them.
+func (s Simulator) GenerateEvents(home, awayHome domain.Team, numberOfHomeEvents, numberOfAwayEvents int, period Period) domain.MatchEventStats {
+	rng := s.rng
+	homeSide := s.side(home)
+	awaySide := s.side(awayHome)
+	homeSide.startPeriod(period)
+	awaySide.startPeriod(period)
+	home, awayHome = homeSide.team, awaySide.team
+
+	homeEvents := teamEvents(rng, &home, &awayHome)
+	awayEvents := teamEvents(rng, &awayHome, &home)
+	var homeTally, awayTally chainTally
+
+	schedule := make([]scheduledEvent, 0, numberOfHomeEvents+numberOfAwayEvents)
 	for i := 0; i < numberOfHomeEvents; i++ {
-		event := homeEvents[rand.Intn(len(homeEvents))]
-		log.Println("team event", event)
-		result, newHomeChances, newAwayChances, newHomeGoals, newAwayGoals, err := event.Execute()
-		if err != nil {
-			fmt.Printf("Error executing home event: %v\n", err)
-			continue
+		schedule = append(schedule, scheduledEvent{minute: period.randomMinute(rng), home: true})
+	}
+	for i := 0; i < numberOfAwayEvents; i++ {
+		schedule = append(schedule, scheduledEvent{minute: period.randomMinute(rng)})
+	}
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].minute < schedule[j].minute
+	})
+
+	halfEnds := period.halfEnds()
+	var addedTime []int
+	var homeFrom, awayFrom int
+	for i := 0; i < len(schedule) || len(halfEnds) > 0; i++ {
+		for len(halfEnds) > 0 && (i == len(schedule) || schedule[i].minute > halfEnds[0]) {
+			halfEvents := append(append([]domain.EventResult{}, homeTally.results[homeFrom:]...), awayTally.results[awayFrom:]...)
+			stoppage := stoppageTime(halfEvents)
+			addedTime = append(addedTime, stoppage)
+			log.Printf("%d minutes of stoppage time at minute %d", stoppage, halfEnds[0])
+
+			homeRate := float64(numberOfHomeEvents) / float64(period.Minutes)
+			awayRate := float64(numberOfAwayEvents) / float64(period.Minutes)
+			schedule = slices.Insert(schedule, i, stoppageSchedule(rng, halfEnds[0], stoppage, homeRate, awayRate)...)
+			halfEnds = halfEnds[1:]
+			homeFrom, awayFrom = len(homeTally.results), len(awayTally.results)
 		}
-		if result == "" {
-			fmt.Println("Generated empty event for home!")
-		} else {
-			fmt.Printf("Generated home event: %s\n", result)
+		if i == len(schedule) {
+			break
 		}
-		homeChances += newHomeChances
-		awayChances += newAwayChances
-		homeGoals += newHomeGoals
-		awayGoals += newAwayGoals
-
-		minute := rand.Intn(90)
-		homeResults = append(homeResults, domain.EventResult{
-			Event:     result + fmt.Sprintf(" for the team %s", home.Name),
-			Minute:    minute,
-			EventType: event.Name,
-			TeamId:    home.Id,
-			TeamName:  fmt.Sprintf(" %s", home.Name),
-		})
-		fmt.Printf("Generated event: %s at minute %d\n", result, minute)
 
-	}
-	for i := 0; i < numberOfAwayEvents; i++ {
-		event := awayEvents[rand.Intn(len(awayEvents))]
-		log.Println("away event", event)
-		result, newAwayChances, newHomeChances, newAwayGoals, newHomeGoals, err := event.Execute()
-		if err != nil {
-			fmt.Printf("Error executing away event: %v\n", err)
-			continue
+		scheduled := schedule[i]
+		minute := scheduled.minute
+		homeBefore, awayBefore := len(homeTally.results), len(awayTally.results)
+		homeTally.results = append(homeTally.results, homeSide.plannedChanges(minute, homeTally.goals-awayTally.goals)...)
+		awayTally.results = append(awayTally.results, awaySide.plannedChanges(minute, awayTally.goals-homeTally.goals)...)
+		home, awayHome = homeSide.team, awaySide.team
+
+		homeTactics := homeSide.changeTactics(minute, homeTally.goals-awayTally.goals)
+		awayTactics := awaySide.changeTactics(minute, awayTally.goals-homeTally.goals)
+		if len(homeTactics)+len(awayTactics) > 0 {
+			homeTally.results = append(homeTally.results, homeTactics...)
+			awayTally.results = append(awayTally.results, awayTactics...)
+			schedule = append(schedule[:i+1], replanEvents(rng, homeSide, awaySide, minute, period, schedule[i+1:])...)
 		}
 
-		homeChances += newHomeChances
-		awayChances += newAwayChances
-		homeGoals += newHomeGoals
-		awayGoals += newAwayGoals
-
-		minute := rand.Intn(90)
-		awayResults = append(awayResults, domain.EventResult{
-			Event:     result + " para " + awayHome.Name,
-			Minute:    minute,
-			EventType: event.Name,
-			TeamId:    awayHome.Id,
-			TeamName:  awayHome.Name,
-		})
-		fmt.Printf("Generated event: %s at minute %d\n", result, minute)
+		played := false
+		if scheduled.home {
+			event := homeEvents[rng.Intn(len(homeEvents))]
+			log.Println("team event", event)
+			outcome, err := event.Execute()
+			if err != nil {
+				fmt.Printf("Error executing home event: %v\n", err)
+			} else {
+				if outcome.Sentence == "" {
+					fmt.Println("Generated empty event for home!")
+				} else {
+					fmt.Printf("Generated home event: %s\n", outcome.Sentence)
+				}
+				playChain(rng, event.Name, outcome, minute, homeSide, awaySide, &homeTally, &awayTally)
+				fmt.Printf("Generated event: %s at minute %d\n", outcome.Sentence, minute)
+				played = true
+			}
+		} else {
+			event := awayEvents[rng.Intn(len(awayEvents))]
+			log.Println("away event", event)
+			outcome, err := event.Execute()
+			if err != nil {
+				fmt.Printf("Error executing away event: %v\n", err)
+			} else {
+				playChain(rng, event.Name, outcome, minute, awaySide, homeSide, &awayTally, &homeTally)
+				fmt.Printf("Generated event: %s at minute %d\n", outcome.Sentence, minute)
+				played = true
+			}
+		}
 
+		if played && rng.Float64() < injuryChancePerEvent {
+			side, results := homeSide, &homeTally.results
+			if rng.Intn(2) == 0 {
+				side, results = awaySide, &awayTally.results
+			}
+			*results = append(*results, injuryDuringMatch(rng, side, minute)...)
+			home, awayHome = homeSide.team, awaySide.team
+		}
+
+		setAddedTime(homeTally.results[homeBefore:], minute, scheduled.addedTime)
+		setAddedTime(awayTally.results[awayBefore:], minute, scheduled.addedTime)
 	}
 
+	periodEnd := period.FirstMinute + period.Minutes
+	homeBefore, awayBefore := len(homeTally.results), len(awayTally.results)
+	homeTally.results = append(homeTally.results, homeSide.plannedChanges(periodEnd, homeTally.goals-awayTally.goals)...)
+	awayTally.results = append(awayTally.results, awaySide.plannedChanges(periodEnd, awayTally.goals-homeTally.goals)...)
+	setAddedTime(homeTally.results[homeBefore:], periodEnd, addedTime[len(addedTime)-1])
+	setAddedTime(awayTally.results[awayBefore:], periodEnd, addedTime[len(addedTime)-1])
+
 	return domain.MatchEventStats{
-		HomeEvents:       homeResults,
-		AwayEvents:       awayResults,
-		HomeScoreChances: homeChances,
-		AwayScoreChances: awayChances,
-		HomeGoals:        homeGoals,
-		AwayGoals:        awayGoals,
+		HomeEvents:       homeTally.results,
+		AwayEvents:       awayTally.results,
+		HomeScoreChances: homeTally.chances,
+		AwayScoreChances: awayTally.chances,
+		HomeGoals:        homeTally.goals,
+		AwayGoals:        awayTally.goals,
+		HomeXG:           roundXG(homeTally.xg),
+		AwayXG:           roundXG(awayTally.xg),
+		AddedTime:        addedTime,
 	}
 }
 
@@ -0,0 +1,143 @@
+package match
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const (
+	baseRating = 6.0
+	minRating  = 1.0
+	maxRating  = 10.0
+)
+
+// What each part of a match adds to or takes from the rating of the players
+// in it.
+const (
+	goalRating       = 1.0
+	assistRating     = 0.5
+	concededRating   = -0.3
+	saveRating       = 0.4
+	blockRating      = 0.3
+	bigMissRating    = -0.5
+	completedRating  = 0.3
+	beatenRating     = -0.2
+	errorRating      = -0.2
+	duelWonRating    = 0.2
+	foulRating       = -0.1
+	yellowCardRating = -0.5
+	redCardRating    = -1.5
+	resultRating     = 0.5
+)
+
+// PlayerRatings rates from 1 to 10 every player who took part in the match,
+// from the events they were in and the result of their team, and names the
+// best of them man of the match. Ties go to the winning side, then to the
+// player who played longer.
+func PlayerRatings(matchID, homeID uuid.UUID, appearances []domain.Appearance, events []domain.EventResult, result domain.Result) []domain.PlayerRating {
+	points := make(map[uuid.UUID]float64)
+	for _, event := range events {
+		addEventRating(event, points)
+	}
+
+	homeDifference := result.HomeStats.Goals - result.AwayStats.Goals
+	ratings := make([]domain.PlayerRating, 0, len(appearances))
+	differences := make([]int, 0, len(appearances))
+	best := -1
+	for _, appearance := range appearances {
+		difference := homeDifference
+		if appearance.TeamID != homeID {
+			difference = -difference
+		}
+
+		rating := baseRating + points[appearance.PlayerID]
+		switch domain.ScoreStateOf(difference) {
+		case domain.ScoreWinning:
+			rating += resultRating
+		case domain.ScoreLosing:
+			rating -= resultRating
+		}
+		rating = math.Round(10*math.Max(minRating, math.Min(rating, maxRating))) / 10
+
+		ratings = append(ratings, domain.PlayerRating{
+			MatchID:  matchID,
+			PlayerID: appearance.PlayerID,
+			TeamID:   appearance.TeamID,
+			Minutes:  appearance.Minutes,
+			Rating:   rating,
+		})
+		differences = append(differences, difference)
+
+		i := len(ratings) - 1
+		if best < 0 || betterRating(ratings[i], differences[i], ratings[best], differences[best]) {
+			best = i
+		}
+	}
+
+	if best >= 0 {
+		ratings[best].ManOfTheMatch = true
+	}
+	return ratings
+}
+
+func betterRating(rating domain.PlayerRating, difference int, best domain.PlayerRating, bestDifference int) bool {
+	if rating.Rating != best.Rating {
+		return rating.Rating > best.Rating
+	}
+	if difference != bestDifference {
+		return difference > bestDifference
+	}
+	return rating.Minutes > best.Minutes
+}
+
+// addEventRating adds what an event says of the players in it. Scorers,
+// assistants, goalkeepers making saves, defenders blocking shots and players
+// winning their duels go up; goalkeepers conceding, players losing the ball,
+// missing a great chance, caught offside, fouling or booked go down. Penalty
+// shootouts do not count.
+func addEventRating(event domain.EventResult, points map[uuid.UUID]float64) {
+	add := func(player *uuid.UUID, value float64) {
+		if player != nil {
+			points[*player] += value
+		}
+	}
+
+	switch EventType(event.EventType) {
+	case EventTypePenaltyShootout:
+		return
+	case EventTypeFoul:
+		add(event.OpponentPlayerID, foulRating)
+		return
+	case EventTypeYellowCard:
+		add(event.PlayerID, yellowCardRating)
+		return
+	case EventTypeRedCard:
+		add(event.PlayerID, redCardRating)
+		return
+	}
+
+	switch event.Outcome {
+	case domain.OutcomeGoal:
+		add(event.PlayerID, goalRating)
+		add(event.SecondaryPlayerID, assistRating)
+		add(event.OpponentPlayerID, concededRating)
+	case domain.OutcomeSaved:
+		add(event.OpponentPlayerID, saveRating)
+	case domain.OutcomeBlocked:
+		add(event.OpponentPlayerID, blockRating)
+	case domain.OutcomeMissed:
+		if EventType(event.EventType) == EventTypeGreatScoringChance {
+			add(event.PlayerID, bigMissRating)
+		}
+	case domain.OutcomeCompleted:
+		add(event.PlayerID, completedRating)
+		add(event.OpponentPlayerID, beatenRating)
+	case domain.OutcomeFailed:
+		add(event.PlayerID, errorRating)
+		add(event.OpponentPlayerID, duelWonRating)
+	case domain.OutcomeOffside:
+		add(event.PlayerID, errorRating)
+	}
+}
@@ -0,0 +1,87 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayerRatings(t *testing.T) {
+	matchID, homeID, awayID := uuid.New(), uuid.New(), uuid.New()
+	scorer, passer, fouler, goalkeeper, unused := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	appearances := []domain.Appearance{
+		{PlayerID: scorer, TeamID: homeID, Minutes: 90},
+		{PlayerID: passer, TeamID: homeID, Minutes: 60},
+		{PlayerID: fouler, TeamID: awayID, Minutes: 90},
+		{PlayerID: goalkeeper, TeamID: awayID, Minutes: 90},
+	}
+	events := []domain.EventResult{
+		{EventType: string(match.EventTypeShot), TeamId: homeID, EventActors: domain.EventActors{PlayerID: &scorer, SecondaryPlayerID: &passer, OpponentPlayerID: &goalkeeper, Outcome: domain.OutcomeGoal}},
+		{EventType: string(match.EventTypeLongShot), TeamId: homeID, EventActors: domain.EventActors{PlayerID: &scorer, OpponentPlayerID: &goalkeeper, Outcome: domain.OutcomeSaved}},
+		{EventType: string(match.EventTypeFoul), TeamId: homeID, EventActors: domain.EventActors{OpponentPlayerID: &fouler}},
+		{EventType: string(match.EventTypeYellowCard), TeamId: awayID, EventActors: domain.EventActors{PlayerID: &fouler}},
+		{EventType: string(match.EventTypePenaltyShootout), TeamId: homeID, EventActors: domain.EventActors{PlayerID: &unused, Outcome: domain.OutcomeGoal}},
+	}
+	result := domain.Result{HomeStats: domain.TeamStats{Goals: 1}}
+
+	ratings := match.PlayerRatings(matchID, homeID, appearances, events, result)
+	assert.Len(t, ratings, 4)
+
+	byPlayer := map[uuid.UUID]domain.PlayerRating{}
+	for _, rating := range ratings {
+		assert.Equal(t, matchID, rating.MatchID)
+		byPlayer[rating.PlayerID] = rating
+	}
+	assert.Equal(t, 7.5, byPlayer[scorer].Rating)
+	assert.Equal(t, 7.0, byPlayer[passer].Rating)
+	assert.Equal(t, 4.9, byPlayer[fouler].Rating)
+	assert.Equal(t, 5.6, byPlayer[goalkeeper].Rating)
+	assert.Equal(t, 60, byPlayer[passer].Minutes)
+
+	assert.True(t, byPlayer[scorer].ManOfTheMatch)
+	assert.False(t, byPlayer[passer].ManOfTheMatch)
+}
+
+func TestPlayerRatingsManOfTheMatchTie(t *testing.T) {
+	homeID, awayID := uuid.New(), uuid.New()
+	appearances := []domain.Appearance{
+		{PlayerID: uuid.New(), TeamID: homeID, Minutes: 90},
+		{PlayerID: uuid.New(), TeamID: awayID, Minutes: 90},
+		{PlayerID: uuid.New(), TeamID: awayID, Minutes: 45},
+	}
+
+	ratings := match.PlayerRatings(uuid.New(), homeID, appearances, nil, domain.Result{})
+	for _, rating := range ratings {
+		assert.Equal(t, 6.0, rating.Rating)
+	}
+	assert.True(t, ratings[0].ManOfTheMatch)
+
+	ratings = match.PlayerRatings(uuid.New(), homeID, appearances, nil, domain.Result{AwayStats: domain.TeamStats{Goals: 2}})
+	assert.Equal(t, 5.5, ratings[0].Rating)
+	assert.True(t, ratings[1].ManOfTheMatch)
+}
+
+func TestPlayerRatingsStayInRange(t *testing.T) {
+	homeID, awayID := uuid.New(), uuid.New()
+	scorer, sentOff := uuid.New(), uuid.New()
+	appearances := []domain.Appearance{
+		{PlayerID: scorer, TeamID: homeID, Minutes: 90},
+		{PlayerID: sentOff, TeamID: awayID, Minutes: 90},
+	}
+	var events []domain.EventResult
+	for i := 0; i < 6; i++ {
+		events = append(events,
+			domain.EventResult{EventType: string(match.EventTypeShot), TeamId: homeID, EventActors: domain.EventActors{PlayerID: &scorer, Outcome: domain.OutcomeGoal}},
+			domain.EventResult{EventType: string(match.EventTypeRedCard), TeamId: awayID, EventActors: domain.EventActors{PlayerID: &sentOff}},
+		)
+	}
+
+	ratings := match.PlayerRatings(uuid.New(), homeID, appearances, events, domain.Result{HomeStats: domain.TeamStats{Goals: 6}})
+
+	assert.Equal(t, 10.0, ratings[0].Rating)
+	assert.Equal(t, 1.0, ratings[1].Rating)
+}
@@ -0,0 +1,80 @@
+package match
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const maxStoppageMinutes = 6
+
+// stoppageTime adds a minute for every three goals, cards, injuries and
+// substitutions of the half, on top of the minute always added.
+func stoppageTime(events []domain.EventResult) int {
+	stoppages := 0
+	for _, event := range events {
+		switch {
+		case event.Outcome == domain.OutcomeGoal,
+			event.EventType == string(EventTypeYellowCard),
+			event.EventType == string(EventTypeRedCard),
+			event.EventType == string(EventTypeInjuryDuringMatch),
+			event.EventType == string(EventTypeSubstitution):
+			stoppages++
+		}
+	}
+	return min(1+stoppages/3, maxStoppageMinutes)
+}
+
+// stoppageSchedule plays on in stoppage time at the pace of the half, so a
+// side gets an event in an added minute as often as in any other.
+func stoppageSchedule(rng *rand.Rand, end, stoppage int, homeRate, awayRate float64) []scheduledEvent {
+	var schedule []scheduledEvent
+	for added := 1; added <= stoppage; added++ {
+		if rng.Float64() < homeRate {
+			schedule = append(schedule, scheduledEvent{minute: end, addedTime: added, home: true})
+		}
+		if rng.Float64() < awayRate {
+			schedule = append(schedule, scheduledEvent{minute: end, addedTime: added})
+		}
+	}
+	return schedule
+}
+
+// setAddedTime marks the events played at minute as played in its stoppage
+// time. Planned changes caught up on at that moment keep the minute of their
+// window and no added time.
+func setAddedTime(events []domain.EventResult, minute, addedTime int) {
+	for i := range events {
+		if events[i].Minute == minute {
+			events[i].AddedTime = addedTime
+		}
+	}
+}
+
+func sortEvents(events []domain.EventResult) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Minute != events[j].Minute {
+			return events[i].Minute < events[j].Minute
+		}
+		return events[i].AddedTime < events[j].AddedTime
+	})
+}
+
+// halfTimeGoals counts the goals of each team up to the break, stoppage time
+// of the first half included.
+func halfTimeGoals(events []domain.EventResult, homeID uuid.UUID, halfTime int) (int, int) {
+	var home, away int
+	for _, event := range events {
+		if event.Minute > halfTime || event.Outcome != domain.OutcomeGoal {
+			continue
+		}
+		if event.TeamId == homeID {
+			home++
+		} else {
+			away++
+		}
+	}
+	return home, away
+}
@@ -0,0 +1,85 @@
+package match
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoppageTime(t *testing.T) {
+	goal := domain.EventResult{EventType: string(EventTypeShot), EventActors: domain.EventActors{Outcome: domain.OutcomeGoal}}
+	card := domain.EventResult{EventType: string(EventTypeYellowCard)}
+	substitution := domain.EventResult{EventType: string(EventTypeSubstitution)}
+	save := domain.EventResult{EventType: string(EventTypeShot), EventActors: domain.EventActors{Outcome: domain.OutcomeSaved}}
+
+	assert.Equal(t, 1, stoppageTime(nil), "a minute is always added")
+	assert.Equal(t, 1, stoppageTime([]domain.EventResult{goal, card, save}))
+	assert.Equal(t, 2, stoppageTime([]domain.EventResult{goal, card, substitution}))
+
+	var busy []domain.EventResult
+	for i := 0; i < 30; i++ {
+		busy = append(busy, substitution)
+	}
+	assert.Equal(t, maxStoppageMinutes, stoppageTime(busy))
+}
+
+func TestStoppageSchedule(t *testing.T) {
+	schedule := stoppageSchedule(rand.New(rand.NewSource(1)), 45, 3, 1, 0)
+
+	assert.Equal(t, []scheduledEvent{
+		{minute: 45, addedTime: 1, home: true},
+		{minute: 45, addedTime: 2, home: true},
+		{minute: 45, addedTime: 3, home: true},
+	}, schedule)
+}
+
+func TestSortEventsPutsStoppageTimeLast(t *testing.T) {
+	events := []domain.EventResult{
+		{Minute: 45, AddedTime: 2},
+		{Minute: 46},
+		{Minute: 45},
+		{Minute: 45, AddedTime: 1},
+	}
+
+	sortEvents(events)
+
+	assert.Equal(t, []domain.EventResult{
+		{Minute: 45},
+		{Minute: 45, AddedTime: 1},
+		{Minute: 45, AddedTime: 2},
+		{Minute: 46},
+	}, events)
+}
+
+func TestHalfTimeGoals(t *testing.T) {
+	homeID, awayID := uuid.New(), uuid.New()
+	goal := func(teamID uuid.UUID, minute int) domain.EventResult {
+		return domain.EventResult{Minute: minute, TeamId: teamID, EventActors: domain.EventActors{Outcome: domain.OutcomeGoal}}
+	}
+	events := []domain.EventResult{
+		goal(homeID, 10),
+		goal(awayID, 45),
+		goal(homeID, 46),
+		{Minute: 30, TeamId: awayID, EventActors: domain.EventActors{Outcome: domain.OutcomeSaved}},
+	}
+
+	home, away := halfTimeGoals(events, homeID, 45)
+
+	assert.Equal(t, 1, home)
+	assert.Equal(t, 1, away, "stoppage time of the first half counts")
+}
+
+func TestSetAddedTimeLeavesCaughtUpChangesAlone(t *testing.T) {
+	events := []domain.EventResult{
+		{Minute: 80, EventType: string(EventTypeSubstitution)},
+		{Minute: 90, EventType: string(EventTypeShot)},
+	}
+
+	setAddedTime(events, 90, 2)
+
+	assert.Zero(t, events[0].AddedTime)
+	assert.Equal(t, 2, events[1].AddedTime)
+}
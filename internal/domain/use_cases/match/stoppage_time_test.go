@@ -0,0 +1,14 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinuteLabel(t *testing.T) {
+	assert.Equal(t, "37", domain.MinuteLabel(37, 0))
+	assert.Equal(t, "45+2", domain.MinuteLabel(45, 2))
+	assert.Equal(t, "90+4", domain.MinuteLabel(90, 4))
+}
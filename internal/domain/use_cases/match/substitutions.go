@@ -0,0 +1,355 @@
+package match
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const (
+	maxSubstitutions       = 5
+	maxSubstitutionWindows = 3
+	maxChangesPerWindow    = 2
+	injuryChancePerEvent   = 0.04
+	minDefenders           = 3
+	protectLeadFromMinute  = 75
+)
+
+// plannedSubstitutionMinutes are the moments the AI manager looks at the bench.
+var plannedSubstitutionMinutes = []int{60, 70, 80}
+
+// Period is a stretch of play: regular time, or the extra time of a cup tie,
+// which allows one more change in one more window.
+type Period struct {
+	FirstMinute        int
+	Minutes            int
+	ExtraSubstitutions int
+}
+
+var (
+	RegularTime = Period{FirstMinute: 0, Minutes: 90}
+	ExtraTime   = Period{FirstMinute: 90, Minutes: extraTimeMinutes, ExtraSubstitutions: 1}
+)
+
+// randomMinute picks a minute of the period as the scoreboard shows it, from
+// the first minute after kick-off to the last one before stoppage time.
+func (p Period) randomMinute(rng *rand.Rand) int {
+	return p.FirstMinute + 1 + rng.Intn(p.Minutes)
+}
+
+func (p Period) halfEnds() []int {
+	return []int{p.FirstMinute + p.Minutes/2, p.FirstMinute + p.Minutes}
+}
+
+// matchSide is a team as the match goes on: who is on the pitch, who is left
+// on the bench and how many changes and windows have been used.
+type matchSide struct {
+	team             domain.Team
+	maxSubstitutions int
+	maxWindows       int
+	substitutions    int
+	windows          int
+	lastWindowMinute int
+	nextPlanned      int
+	yellowCards      map[uuid.UUID]int
+	bookings         []domain.Booking
+	sentOff          []sentOffPlayer
+	injuries         []domain.Injury
+	squad            map[uuid.UUID]domain.Player
+	appeared         []uuid.UUID
+	onSince          map[uuid.UUID]int
+	minutes          map[uuid.UUID]int
+	periodEnd        int
+	cardLeniency     float64
+	homeAdvantage    float64
+	strategy         domain.Strategy
+	strategyResult   strategyResult
+	tacticalChanges  []domain.TacticalChange
+	hasStrategy      bool
+}
+
+// newMatchSide takes the players as they play today (see EffectivePlayer) and
+// keeps the squad as given to work out what the match cost them.
+func newMatchSide(team domain.Team) *matchSide {
+	side := &matchSide{
+		maxSubstitutions: maxSubstitutions,
+		maxWindows:       maxSubstitutionWindows,
+		lastWindowMinute: -1,
+		yellowCards:      make(map[uuid.UUID]int),
+		squad:            make(map[uuid.UUID]domain.Player),
+		onSince:          make(map[uuid.UUID]int),
+		minutes:          make(map[uuid.UUID]int),
+		strategyResult:   neutralStrategy,
+	}
+	for _, p := range append(append([]domain.Player{}, team.Players...), team.Bench...) {
+		side.squad[p.PlayerId] = p
+	}
+	for _, p := range team.Players {
+		side.comeOn(p.PlayerId, 0)
+	}
+
+	team.Players = effectivePlayers(team.Players)
+	team.Bench = effectivePlayers(team.Bench)
+	side.team = team
+	return side
+}
+
+func (s *matchSide) comeOn(playerID uuid.UUID, minute int) {
+	s.onSince[playerID] = minute
+	s.appeared = append(s.appeared, playerID)
+}
+
+func (s *matchSide) goOff(playerID uuid.UUID, minute int) {
+	if since, ok := s.onSince[playerID]; ok {
+		s.minutes[playerID] += minute - since
+		delete(s.onSince, playerID)
+	}
+}
+
+func (s *matchSide) appearances() []domain.Appearance {
+	appearances := make([]domain.Appearance, 0, len(s.appeared))
+	for _, playerID := range s.appeared {
+		minutes := s.minutes[playerID]
+		if since, ok := s.onSince[playerID]; ok {
+			minutes += s.periodEnd - since
+		}
+		appearances = append(appearances, domain.Appearance{
+			PlayerID:    playerID,
+			TeamID:      s.team.Id,
+			Minutes:     minutes,
+			FitnessLoss: FitnessLoss(s.squad[playerID], minutes),
+		})
+	}
+	return appearances
+}
+
+func (s *matchSide) startPeriod(period Period) {
+	s.periodEnd = period.FirstMinute + period.Minutes
+	s.maxSubstitutions += period.ExtraSubstitutions
+	if period.ExtraSubstitutions > 0 {
+		s.maxWindows++
+	}
+}
+
+func (s *matchSide) canSubstitute(minute int) bool {
+	if s.substitutions >= s.maxSubstitutions || len(s.team.Bench) == 0 {
+		return false
+	}
+	return minute == s.lastWindowMinute || s.windows < s.maxWindows
+}
+
+// substitute takes off the player and brings on the bench player, using up a
+// window unless another change was already made in the same minute.
+func (s *matchSide) substitute(minute int, out, in domain.Player) domain.EventResult {
+	if minute != s.lastWindowMinute {
+		s.windows++
+		s.lastWindowMinute = minute
+	}
+	s.substitutions++
+
+	s.team.Players = replacePlayer(s.team.Players, out.PlayerId, &in)
+	s.team.Bench = replacePlayer(s.team.Bench, in.PlayerId, nil)
+	s.goOff(out.PlayerId, minute)
+	s.comeOn(in.PlayerId, minute)
+
+	sentence := fmt.Sprintf("Substitution: %s %s comes on for %s %s", in.FirstName, in.LastName, out.FirstName, out.LastName)
+	log.Printf("%s (team %s, minute %d)", sentence, s.team.Name, minute)
+
+	return domain.EventResult{
+		Event:     sentence,
+		Minute:    minute,
+		EventType: string(EventTypeSubstitution),
+		TeamId:    s.team.Id,
+		TeamName:  s.team.Name,
+		EventActors: domain.EventActors{
+			PlayerID:          playerID(&in),
+			SecondaryPlayerID: playerID(&out),
+		},
+	}
+}
+
+// forcedChange replaces an injured player. Without changes or substitutes left
+// the team carries on with one player fewer, covering the position if the
+// injured player was the last one there.
+func (s *matchSide) forcedChange(minute int, injured domain.Player) []domain.EventResult {
+	if s.canSubstitute(minute) {
+		if in := s.bestOnBench(injured.Position); in != nil {
+			return []domain.EventResult{s.substitute(minute, injured, *in)}
+		}
+	}
+
+	s.team.Players = replacePlayer(s.team.Players, injured.PlayerId, nil)
+	s.goOff(injured.PlayerId, minute)
+	log.Printf("team %s cannot replace %s %s and plays with %d players", s.team.Name, injured.FirstName, injured.LastName, len(s.team.Players))
+	return s.coverPosition(minute, injured.Position)
+}
+
+// plannedChanges runs the AI manager for every planned window reached by
+// minute: chase a result when losing, protect a late lead and refresh tired legs.
+func (s *matchSide) plannedChanges(minute, goalDifference int) []domain.EventResult {
+	var events []domain.EventResult
+	for s.nextPlanned < len(plannedSubstitutionMinutes) && plannedSubstitutionMinutes[s.nextPlanned] <= minute {
+		window := plannedSubstitutionMinutes[s.nextPlanned]
+		s.nextPlanned++
+
+		changes := 0
+		if goalDifference < 0 && s.canSubstitute(window) {
+			if out, in := s.attackingChange(); out != nil && in != nil {
+				events = append(events, s.substitute(window, *out, *in))
+				changes++
+			}
+		}
+		if goalDifference > 0 && window >= protectLeadFromMinute && s.canSubstitute(window) {
+			if out, in := s.defensiveChange(); out != nil && in != nil {
+				events = append(events, s.substitute(window, *out, *in))
+				changes++
+			}
+		}
+		for changes < maxChangesPerWindow && s.canSubstitute(window) {
+			out := s.mostTired(window)
+			if out == nil {
+				break
+			}
+			in := s.bestOnBench(out.Position)
+			if in == nil {
+				break
+			}
+			events = append(events, s.substitute(window, *out, *in))
+			changes++
+		}
+	}
+	return events
+}
+
+func (s *matchSide) attackingChange() (*domain.Player, *domain.Player) {
+	if countPosition(s.team.Players, domain.PositionDefender) <= minDefenders {
+		return nil, nil
+	}
+	return weakest(s.team.Players, domain.PositionDefender), bestOfPosition(s.team.Bench, domain.PositionForward)
+}
+
+func (s *matchSide) defensiveChange() (*domain.Player, *domain.Player) {
+	if countPosition(s.team.Players, domain.PositionForward) <= 1 {
+		return nil, nil
+	}
+	return weakest(s.team.Players, domain.PositionForward), bestOfPosition(s.team.Bench, domain.PositionDefender)
+}
+
+// mostTired returns the outfield player who ran out of legs first, if anyone
+// did by minute.
+func (s *matchSide) mostTired(minute int) *domain.Player {
+	var tired *domain.Player
+	for i, p := range s.team.Players {
+		if p.Position == domain.PositionGoalkeeper || fatigueMinute(p) > minute {
+			continue
+		}
+		if tired == nil || fatigueMinute(p) < fatigueMinute(*tired) {
+			tired = &s.team.Players[i]
+		}
+	}
+	if tired == nil {
+		return nil
+	}
+	player := *tired
+	return &player
+}
+
+// bestOnBench prefers a like-for-like change and falls back to the best
+// outfield substitute.
+func (s *matchSide) bestOnBench(position string) *domain.Player {
+	if in := bestOfPosition(s.team.Bench, position); in != nil {
+		return in
+	}
+	if position == domain.PositionGoalkeeper {
+		return nil
+	}
+	var best *domain.Player
+	for i, p := range s.team.Bench {
+		if p.Position == domain.PositionGoalkeeper {
+			continue
+		}
+		if best == nil || playerQuality(p) > playerQuality(*best) {
+			best = &s.team.Bench[i]
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	player := *best
+	return &player
+}
+
+// fatigueMinute estimates when a player starts to tire from physique and,
+// when known, match fitness.
+func fatigueMinute(p domain.Player) int {
+	stamina := p.Physique
+	if p.Fitness > 0 {
+		stamina = (p.Physique + p.Fitness) / 2
+	}
+	return 30 + stamina*6/10
+}
+
+func playerQuality(p domain.Player) int {
+	return p.Technique + p.Mental + p.Physique
+}
+
+func countPosition(players []domain.Player, position string) int {
+	count := 0
+	for _, p := range players {
+		if p.Position == position {
+			count++
+		}
+	}
+	return count
+}
+
+func bestOfPosition(players []domain.Player, position string) *domain.Player {
+	candidates := playersOfPosition(players, position)
+	if len(candidates) == 0 {
+		return nil
+	}
+	return &candidates[len(candidates)-1]
+}
+
+func weakest(players []domain.Player, position string) *domain.Player {
+	candidates := playersOfPosition(players, position)
+	if len(candidates) == 0 {
+		return nil
+	}
+	return &candidates[0]
+}
+
+// playersOfPosition returns copies of the players in the position ordered from
+// weakest to strongest.
+func playersOfPosition(players []domain.Player, position string) []domain.Player {
+	var candidates []domain.Player
+	for _, p := range players {
+		if p.Position == position {
+			candidates = append(candidates, p)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return playerQuality(candidates[i]) < playerQuality(candidates[j])
+	})
+	return candidates
+}
+
+// replacePlayer returns a new slice where the player is swapped for
+// replacement, or dropped when replacement is nil.
+func replacePlayer(players []domain.Player, playerID uuid.UUID, replacement *domain.Player) []domain.Player {
+	result := make([]domain.Player, 0, len(players))
+	for _, p := range players {
+		if p.PlayerId != playerID {
+			result = append(result, p)
+			continue
+		}
+		if replacement != nil {
+			result = append(result, *replacement)
+		}
+	}
+	return result
+}
@@ -0,0 +1,201 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func testPlayer(position string, quality int) domain.Player {
+	return domain.Player{
+		PlayerId:  uuid.New(),
+		LastName:  position,
+		Position:  position,
+		Technique: quality,
+		Mental:    quality,
+		Physique:  quality,
+		Fitness:   100,
+	}
+}
+
+// testSide lines up a 4-3-3, weakest first in every line, with a goalkeeper,
+// a defender, a midfielder and a forward on the bench. Nobody tires before
+// the last planned window.
+func testSide() *matchSide {
+	team := domain.Team{Id: uuid.New(), Name: "Test"}
+	lines := []struct {
+		position string
+		players  int
+	}{
+		{domain.PositionGoalkeeper, 1},
+		{domain.PositionDefender, 4},
+		{domain.PositionMidfielder, 3},
+		{domain.PositionForward, 3},
+	}
+	for _, line := range lines {
+		for i := 0; i < line.players; i++ {
+			team.Players = append(team.Players, testPlayer(line.position, 70+i))
+		}
+	}
+	team.Bench = []domain.Player{
+		testPlayer(domain.PositionGoalkeeper, 65),
+		testPlayer(domain.PositionDefender, 70),
+		testPlayer(domain.PositionMidfielder, 70),
+		testPlayer(domain.PositionForward, 75),
+	}
+	return newMatchSide(team)
+}
+
+func onPitch(side *matchSide, playerID uuid.UUID) bool {
+	for _, p := range side.team.Players {
+		if p.PlayerId == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSubstitutionWindows(t *testing.T) {
+	side := testSide()
+	side.team.Bench = append(side.team.Bench, testPlayer(domain.PositionMidfielder, 60), testPlayer(domain.PositionMidfielder, 61))
+	players, bench := side.team.Players, side.team.Bench
+
+	event := side.substitute(60, players[1], bench[1])
+	assert.Equal(t, string(EventTypeSubstitution), event.EventType)
+	assert.Equal(t, &bench[1].PlayerId, event.PlayerID)
+	assert.Equal(t, &players[1].PlayerId, event.SecondaryPlayerID)
+	assert.True(t, onPitch(side, bench[1].PlayerId))
+	assert.False(t, onPitch(side, players[1].PlayerId))
+	assert.Len(t, side.team.Players, 11)
+	assert.Len(t, side.team.Bench, 5)
+
+	side.substitute(60, players[5], bench[2])
+	assert.Equal(t, 1, side.windows, "changes in the same minute share a window")
+
+	side.substitute(70, players[10], bench[3])
+	side.substitute(80, players[6], bench[4])
+	assert.Equal(t, 3, side.windows)
+	assert.False(t, side.canSubstitute(85), "no windows left")
+	assert.True(t, side.canSubstitute(80), "the last window is still open")
+
+	side.substitute(80, players[2], bench[0])
+	assert.Equal(t, 5, side.substitutions)
+	assert.False(t, side.canSubstitute(80), "no changes left")
+}
+
+func TestExtraTimeAllowsOneMoreChange(t *testing.T) {
+	side := testSide()
+	side.substitutions, side.windows, side.lastWindowMinute = maxSubstitutions, maxSubstitutionWindows, 80
+	assert.False(t, side.canSubstitute(95))
+
+	side.startPeriod(ExtraTime)
+	assert.True(t, side.canSubstitute(95))
+	side.substitute(95, side.team.Players[1], side.team.Bench[1])
+	assert.False(t, side.canSubstitute(105))
+}
+
+func TestForcedChangeReplacesTheInjuredPlayer(t *testing.T) {
+	side := testSide()
+	injured, substitute := side.team.Players[2], side.team.Bench[1]
+
+	events := side.forcedChange(30, injured)
+
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, &substitute.PlayerId, events[0].PlayerID, "like for like")
+		assert.Equal(t, &injured.PlayerId, events[0].SecondaryPlayerID)
+		assert.Equal(t, 30, events[0].Minute)
+	}
+	assert.Len(t, side.team.Players, 11)
+	assert.False(t, onPitch(side, injured.PlayerId))
+}
+
+func TestForcedChangeWithoutChangesLeft(t *testing.T) {
+	side := testSide()
+	side.substitutions = maxSubstitutions
+	injured := side.team.Players[2]
+
+	events := side.forcedChange(30, injured)
+
+	assert.Empty(t, events)
+	assert.Len(t, side.team.Players, 10)
+	assert.False(t, onPitch(side, injured.PlayerId))
+}
+
+func TestForcedChangeKeepsEveryPositionManned(t *testing.T) {
+	t.Run("empty bench", func(t *testing.T) {
+		side := testSide()
+		side.team.Bench = nil
+		for _, defender := range side.team.Players[1:4] {
+			side.team.Players = replacePlayer(side.team.Players, defender.PlayerId, nil)
+		}
+		injured, forward := side.team.Players[1], side.team.Players[5]
+
+		events := side.forcedChange(30, injured)
+
+		assert.Empty(t, events)
+		assert.Len(t, side.team.Players, 7)
+		assert.False(t, onPitch(side, injured.PlayerId))
+		if cover := bestOfPosition(side.team.Players, domain.PositionDefender); assert.NotNil(t, cover) {
+			assert.Equal(t, forward.PlayerId, cover.PlayerId, "the weakest forward drops back")
+		}
+	})
+
+	t.Run("no changes left", func(t *testing.T) {
+		side := testSide()
+		side.substitutions = maxSubstitutions
+		keeper, forward := side.team.Players[0], side.team.Players[8]
+
+		events := side.forcedChange(30, keeper)
+
+		assert.Empty(t, events)
+		assert.Len(t, side.team.Players, 10)
+		if cover := bestOfPosition(side.team.Players, domain.PositionGoalkeeper); assert.NotNil(t, cover) {
+			assert.Equal(t, forward.PlayerId, cover.PlayerId, "the weakest forward goes in goal")
+		}
+	})
+}
+
+func TestPlannedChanges(t *testing.T) {
+	t.Run("losing brings on a forward for a defender", func(t *testing.T) {
+		side := testSide()
+		defender, forward := side.team.Players[1], side.team.Bench[3]
+
+		events := side.plannedChanges(60, -1)
+
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, &forward.PlayerId, events[0].PlayerID)
+			assert.Equal(t, &defender.PlayerId, events[0].SecondaryPlayerID)
+		}
+	})
+
+	t.Run("a late lead brings on a defender for a forward", func(t *testing.T) {
+		side := testSide()
+		forward, defender := side.team.Players[8], side.team.Bench[1]
+
+		events := side.plannedChanges(75, 1)
+		assert.Empty(t, events, "too early to protect the lead")
+
+		events = side.plannedChanges(80, 1)
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, 80, events[0].Minute)
+			assert.Equal(t, &defender.PlayerId, events[0].PlayerID)
+			assert.Equal(t, &forward.PlayerId, events[0].SecondaryPlayerID)
+		}
+	})
+
+	t.Run("tired players are replaced", func(t *testing.T) {
+		side := testSide()
+		side.team.Players[6].Fitness = 20
+		tired, midfielder := side.team.Players[6], side.team.Bench[2]
+
+		events := side.plannedChanges(60, 0)
+
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, &midfielder.PlayerId, events[0].PlayerID)
+			assert.Equal(t, &tired.PlayerId, events[0].SecondaryPlayerID)
+		}
+		assert.Empty(t, side.plannedChanges(70, 0), "nobody else is tired")
+	})
+}
@@ -0,0 +1,46 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func withBench(team domain.Team, quality int) domain.Team {
+	positions := []string{
+		domain.PositionGoalkeeper,
+		domain.PositionDefender, domain.PositionDefender,
+		domain.PositionMidfielder, domain.PositionMidfielder,
+		domain.PositionForward, domain.PositionForward,
+	}
+	for _, position := range positions {
+		team.Bench = append(team.Bench, domain.Player{
+			PlayerId:  uuid.New(),
+			FirstName: team.Name,
+			LastName:  "sub " + position,
+			Position:  position,
+			Technique: quality,
+			Mental:    quality,
+			Physique:  quality,
+			Benched:   true,
+			Fitness:   90,
+		})
+	}
+	return team
+}
+
+func TestSimulatorDoesNotChangeTheCallersLineup(t *testing.T) {
+	m := newTestMatch()
+	m.HomeMatchStrategy.StrategyTeam = withBench(m.HomeMatchStrategy.StrategyTeam, 75)
+	starters := append([]domain.Player{}, m.HomeMatchStrategy.StrategyTeam.Players...)
+	bench := append([]domain.Player{}, m.HomeMatchStrategy.StrategyTeam.Bench...)
+
+	_, _, err := match.NewSimulator(3).Play(m)
+
+	assert.NoError(t, err)
+	assert.Equal(t, starters, m.HomeMatchStrategy.StrategyTeam.Players)
+	assert.Equal(t, bench, m.HomeMatchStrategy.StrategyTeam.Bench)
+}
@@ -0,0 +1,105 @@
+package match
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// useStrategy sets the strategy the side starts with and the tactical changes
+// it may make during the match.
+func (s *matchSide) useStrategy(strategy domain.Strategy, result strategyResult) {
+	s.strategy = strategy
+	s.strategyResult = result
+	s.tacticalChanges = append([]domain.TacticalChange{}, strategy.TacticalChanges...)
+	s.hasStrategy = true
+}
+
+// ensureStrategy gives a side that starts straight in extra time its strategy,
+// leaving possession and chances to the players as extra time always did.
+func (s *matchSide) ensureStrategy(strategy domain.Strategy) {
+	if !s.hasStrategy {
+		s.useStrategy(strategy, neutralStrategy)
+	}
+}
+
+// changeTactics follows the instructions whose trigger fires, each one once,
+// and works out again what the new strategy gives the side.
+func (s *matchSide) changeTactics(minute, goalDifference int) []domain.EventResult {
+	var events []domain.EventResult
+	var pending []domain.TacticalChange
+	for _, change := range s.tacticalChanges {
+		if !change.Triggered(minute, goalDifference) {
+			pending = append(pending, change)
+			continue
+		}
+
+		strategy := change.Apply(s.strategy)
+		result, err := CalculateResultOfStrategy(s.team.Players, strategy.Formation, strategy.PlayingStyle, strategy.GameTempo, strategy.PassingStyle, strategy.DefensivePositioning, strategy.BuildUpPlay, strategy.AttackFocus, strategy.KeyPlayerUsage)
+		if err != nil {
+			log.Printf("ignoring tactical change of team %s: %v", s.team.Name, err)
+			continue
+		}
+		s.strategy, s.strategyResult = strategy, result
+
+		sentence := fmt.Sprintf("%s change tactics: %s", s.team.Name, change.Instructions())
+		log.Printf("%s (minute %d)", sentence, minute)
+		events = append(events, domain.EventResult{
+			Event:     sentence,
+			Minute:    minute,
+			EventType: string(EventTypeTacticalChange),
+			TeamId:    s.team.Id,
+			TeamName:  s.team.Name,
+		})
+	}
+	s.tacticalChanges = pending
+	return events
+}
+
+// replanEvents hands out again the events left in the period after a
+// tactical change, from the tempo and chances the sides now play with. The
+// events of the current minute, stoppage time included, are kept.
+func replanEvents(rng *rand.Rand, home, away *matchSide, minute int, period Period, planned []scheduledEvent) []scheduledEvent {
+	remaining := period.FirstMinute + period.Minutes - minute
+	if remaining <= 0 {
+		return planned
+	}
+
+	numberOfMatchEvents, err := CalculateNumberOfMatchEvents(rng, home.strategy.GameTempo, away.strategy.GameTempo)
+	if err != nil {
+		log.Printf("keeping the planned events after a tactical change: %v", err)
+		return planned
+	}
+	numberOfMatchEvents = numberOfMatchEvents * remaining / 90
+
+	homeFactor := home.strategyResult.homeChances + away.strategyResult.awayChances
+	awayFactor := away.strategyResult.homeChances + home.strategyResult.awayChances
+	numberOfHomeEvents, numberOfAwayEvents, err := DistributeMatchEvents(rng, home.team, away.team, numberOfMatchEvents, homeFactor, awayFactor)
+	if err != nil {
+		log.Printf("keeping the planned events after a tactical change: %v", err)
+		return planned
+	}
+	numberOfHomeEvents, numberOfAwayEvents = shiftEventsHome(rng, numberOfHomeEvents, numberOfAwayEvents, home.homeAdvantage)
+
+	var kept []scheduledEvent
+	for _, event := range planned {
+		if event.minute <= minute {
+			kept = append(kept, event)
+		}
+	}
+
+	schedule := make([]scheduledEvent, 0, numberOfHomeEvents+numberOfAwayEvents)
+	for i := 0; i < numberOfHomeEvents; i++ {
+		schedule = append(schedule, scheduledEvent{minute: minute + 1 + rng.Intn(remaining), home: true})
+	}
+	for i := 0; i < numberOfAwayEvents; i++ {
+		schedule = append(schedule, scheduledEvent{minute: minute + 1 + rng.Intn(remaining)})
+	}
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].minute < schedule[j].minute
+	})
+	return append(kept, schedule...)
+}
@@ -0,0 +1,74 @@
+package match
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func withTestStrategy(t *testing.T, side *matchSide, changes ...domain.TacticalChange) *matchSide {
+	strategy := domain.Strategy{
+		StrategyTeam:         side.team,
+		Formation:            "4-3-3",
+		PlayingStyle:         "possession",
+		GameTempo:            "fast_tempo",
+		PassingStyle:         "short",
+		DefensivePositioning: "zonal_marking",
+		BuildUpPlay:          "play_from_back",
+		AttackFocus:          "wide_play",
+		KeyPlayerUsage:       "reference_player",
+		TacticalChanges:      changes,
+	}
+	result, err := CalculateResultOfStrategy(side.team.Players, strategy.Formation, strategy.PlayingStyle, strategy.GameTempo, strategy.PassingStyle, strategy.DefensivePositioning, strategy.BuildUpPlay, strategy.AttackFocus, strategy.KeyPlayerUsage)
+	assert.NoError(t, err)
+	side.useStrategy(strategy, result)
+	return side
+}
+
+func TestChangeTactics(t *testing.T) {
+	side := withTestStrategy(t, testSide(), domain.TacticalChange{
+		Score:        domain.ScoreDrawing,
+		FromMinute:   30,
+		PlayingStyle: "high_press",
+		GameTempo:    "slow_tempo",
+	})
+
+	assert.Empty(t, side.changeTactics(29, 0), "too early")
+	assert.Empty(t, side.changeTactics(30, 1), "not drawing")
+
+	events := side.changeTactics(40, 0)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, string(EventTypeTacticalChange), events[0].EventType)
+		assert.Equal(t, side.team.Id, events[0].TeamId)
+		assert.Equal(t, 40, events[0].Minute)
+		assert.Contains(t, events[0].Event, "high_press, slow_tempo")
+	}
+	assert.Equal(t, "high_press", side.strategy.PlayingStyle)
+	assert.Equal(t, "slow_tempo", side.strategy.GameTempo)
+	assert.Equal(t, "short", side.strategy.PassingStyle)
+
+	result, err := CalculateResultOfStrategy(side.team.Players, "4-3-3", "high_press", "slow_tempo", "short", "zonal_marking", "play_from_back", "wide_play", "reference_player")
+	assert.NoError(t, err)
+	assert.Equal(t, result, side.strategyResult)
+
+	assert.Empty(t, side.changeTactics(50, 0), "each change is made once")
+}
+
+func TestReplanEvents(t *testing.T) {
+	home, away := withTestStrategy(t, testSide()), withTestStrategy(t, testSide())
+	planned := []scheduledEvent{{minute: 20, home: true}, {minute: 40}, {minute: 70, home: true}}
+	rng := rand.New(rand.NewSource(1))
+
+	replanned := replanEvents(rng, home, away, 40, RegularTime, planned)
+
+	assert.Equal(t, planned[:2], replanned[:2], "events up to the change are kept")
+	for i, event := range replanned[2:] {
+		assert.Greater(t, event.minute, 40)
+		assert.LessOrEqual(t, event.minute, 90)
+		assert.LessOrEqual(t, replanned[i+1].minute, event.minute)
+	}
+
+	assert.Equal(t, planned, replanEvents(rng, home, away, 90, RegularTime, planned), "nothing left to replan")
+}
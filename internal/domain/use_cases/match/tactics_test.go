@@ -0,0 +1,29 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTacticalChangeApply(t *testing.T) {
+	change := domain.TacticalChange{
+		Score:        domain.ScoreLosing,
+		FromMinute:   60,
+		PlayingStyle: "high_press",
+		GameTempo:    "fast_tempo",
+	}
+
+	assert.False(t, change.Triggered(59, -1))
+	assert.False(t, change.Triggered(70, 0))
+	assert.True(t, change.Triggered(60, -2))
+
+	strategy := newTestMatch().AwayMatchStrategy
+	changed := change.Apply(strategy)
+	assert.Equal(t, "high_press", changed.PlayingStyle)
+	assert.Equal(t, "fast_tempo", changed.GameTempo)
+	assert.Equal(t, strategy.Formation, changed.Formation)
+	assert.Equal(t, strategy.PassingStyle, changed.PassingStyle)
+	assert.Equal(t, "high_press, fast_tempo", change.Instructions())
+}
@@ -0,0 +1,72 @@
+package match
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// addEventStats adds to the stats of each team what its events show. Fouls
+// and saves count for the team that commits or makes them, the rival of the
+// team whose event it is.
+func addEventStats(events []domain.EventResult, homeID uuid.UUID, home, away *domain.TeamStats) {
+	for _, event := range events {
+		team, rival := away, home
+		if event.TeamId == homeID {
+			team, rival = home, away
+		}
+
+		switch EventType(event.EventType) {
+		case EventTypeShot, EventTypeLongShot, EventTypePenaltyKick, EventTypeDirectFreeKick,
+			EventTypeIndirectFreeKick, EventTypeGreatScoringChance:
+			addShot(team, rival, event.Outcome)
+		case EventTypeCornerKick:
+			team.Corners++
+			if event.Outcome != domain.OutcomeFailed {
+				addShot(team, rival, event.Outcome)
+			}
+		case EventTypeKeyPass:
+			addPass(team, event.Outcome == domain.OutcomeCompleted)
+		case EventTypeOffside:
+			addPass(team, event.Outcome == domain.OutcomeCompleted)
+			if event.Outcome == domain.OutcomeOffside {
+				team.Offsides++
+			}
+		case EventTypeFoul:
+			rival.Fouls++
+		case EventTypeYellowCard:
+			team.YellowCards++
+		case EventTypeRedCard:
+			team.RedCards++
+		}
+	}
+
+	for _, stats := range []*domain.TeamStats{home, away} {
+		stats.PassSuccess = domain.PassPercentage(stats.PassesCompleted, stats.Passes)
+	}
+}
+
+func addShot(team, rival *domain.TeamStats, outcome domain.Outcome) {
+	team.Shots++
+	switch outcome {
+	case domain.OutcomeGoal:
+		team.ShotsOnTarget++
+	case domain.OutcomeSaved:
+		team.ShotsOnTarget++
+		rival.Saves++
+	}
+}
+
+func addPass(team *domain.TeamStats, completed bool) {
+	team.Passes++
+	if completed {
+		team.PassesCompleted++
+	}
+}
+
+// matchTeamStats lists the stats of both teams to be saved with the match.
+func matchTeamStats(matchID, homeID, awayID uuid.UUID, result domain.Result) []domain.MatchTeamStats {
+	return []domain.MatchTeamStats{
+		{MatchID: matchID, TeamID: homeID, TeamStats: result.HomeStats},
+		{MatchID: matchID, TeamID: awayID, TeamStats: result.AwayStats},
+	}
+}
@@ -0,0 +1,48 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddEventStats(t *testing.T) {
+	homeID, awayID := uuid.New(), uuid.New()
+	event := func(teamID uuid.UUID, eventType EventType, outcome domain.Outcome) domain.EventResult {
+		return domain.EventResult{TeamId: teamID, EventType: string(eventType), EventActors: domain.EventActors{Outcome: outcome}}
+	}
+	events := []domain.EventResult{
+		event(homeID, EventTypeShot, domain.OutcomeGoal),
+		event(homeID, EventTypeLongShot, domain.OutcomeSaved),
+		event(homeID, EventTypeCornerKick, domain.OutcomeFailed),
+		event(homeID, EventTypeCornerKick, domain.OutcomeBlocked),
+		event(homeID, EventTypeKeyPass, domain.OutcomeCompleted),
+		event(homeID, EventTypeOffside, domain.OutcomeOffside),
+		event(awayID, EventTypeKeyPass, domain.OutcomeFailed),
+		event(awayID, EventTypeFoul, ""),
+		event(homeID, EventTypeYellowCard, ""),
+		event(awayID, EventTypeRedCard, ""),
+	}
+
+	var home, away domain.TeamStats
+	addEventStats(events, homeID, &home, &away)
+
+	assert.Equal(t, domain.TeamStats{
+		Shots:           3,
+		ShotsOnTarget:   2,
+		Corners:         2,
+		Passes:          2,
+		PassesCompleted: 1,
+		PassSuccess:     50,
+		Offsides:        1,
+		Fouls:           1,
+		YellowCards:     1,
+	}, home)
+	assert.Equal(t, domain.TeamStats{
+		Saves:    1,
+		Passes:   1,
+		RedCards: 1,
+	}, away)
+}
@@ -0,0 +1,13 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassPercentage(t *testing.T) {
+	assert.Equal(t, 0, domain.PassPercentage(0, 0))
+	assert.Equal(t, 75, domain.PassPercentage(3, 4))
+}
@@ -0,0 +1,61 @@
+package match
+
+import (
+	"math"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// greatChanceXG is how often a great scoring chance ends in a goal, whoever
+// takes it.
+const greatChanceXG = 0.7
+
+// ConfrontationChance is the chance CalculateSuccessConfrontation gives the
+// attacker, so the xG of a shot decided by it.
+func ConfrontationChance(atackerSkill, defenderSkill int) float64 {
+	switch {
+	case atackerSkill < defenderSkill-91:
+		return 0
+	case atackerSkill < defenderSkill-74:
+		return 1.0 / 7
+	case atackerSkill < defenderSkill-69:
+		return 1.0 / 5
+	case atackerSkill < defenderSkill-61:
+		return 1.0 / 4
+	case atackerSkill < defenderSkill-52:
+		return 1.0 / 3
+	case atackerSkill < defenderSkill-43:
+		return 2.0 / 5
+	case atackerSkill < defenderSkill-30:
+		return 11.0 / 25
+	case atackerSkill < defenderSkill-12:
+		return 1.0 / 2
+	case atackerSkill < defenderSkill:
+		return 4.0 / 7
+	case atackerSkill < defenderSkill+20:
+		return 5.0 / 8
+	case atackerSkill < defenderSkill+33:
+		return 2.0 / 3
+	case atackerSkill < defenderSkill+37:
+		return 0
+	case atackerSkill < defenderSkill+49:
+		return 3.0 / 4
+	case atackerSkill < defenderSkill+64:
+		return 4.0 / 5
+	case atackerSkill < defenderSkill+77:
+		return 9.0 / 10
+	case atackerSkill < defenderSkill+96:
+		return 47.0 / 50
+	}
+	return 1
+}
+
+func shotOutcome(xg float64, sentence string, lineupChances, rivalChances, lineupGoals, rivalGoals int, actors domain.EventActors) domain.EventOutcome {
+	outcome := eventOutcome(sentence, lineupChances, rivalChances, lineupGoals, rivalGoals, actors)
+	outcome.XG = roundXG(xg)
+	return outcome
+}
+
+func roundXG(xg float64) float64 {
+	return math.Round(xg*100) / 100
+}
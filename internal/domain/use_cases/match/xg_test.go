@@ -0,0 +1,58 @@
+package match_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfrontationChanceMatchesConfrontations(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for difference := -100; difference <= 100; difference += 3 {
+		wins := 0
+		for i := 0; i < 1000; i++ {
+			wins += match.CalculateSuccessConfrontation(rng, 100+difference, 100)
+		}
+		assert.InDelta(t, match.ConfrontationChance(100+difference, 100), float64(wins)/1000, 0.06, "skill difference %d", difference)
+	}
+}
+
+func TestShotsCarryExpectedGoals(t *testing.T) {
+	home, away := newTestTeam("Home", 80), newTestTeam("Away", 80)
+	rng := rand.New(rand.NewSource(1))
+
+	penalty, err := match.PenaltyKick(rng, home, away)
+	assert.NoError(t, err)
+	assert.Greater(t, penalty.XG, 0.0)
+
+	weakPenalty, err := match.PenaltyKick(rand.New(rand.NewSource(1)), newTestTeam("Weak", 20), away)
+	assert.NoError(t, err)
+	assert.Less(t, weakPenalty.XG, penalty.XG)
+
+	chance, err := match.GreatScoringChance(rng, home)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.7, chance.XG)
+
+	pass, err := match.KeyPass(rng, home, away)
+	assert.NoError(t, err)
+	assert.Zero(t, pass.XG)
+}
+
+func TestShotExpectedGoals(t *testing.T) {
+	home, away := newTestTeam("Home", 80), newTestTeam("Away", 70)
+	for i := range home.Players {
+		home.Players[i].Technique = 80
+	}
+	for i := range away.Players {
+		away.Players[i].Technique = 70
+	}
+
+	outcome, err := match.Shot(rand.New(rand.NewSource(1)), home, away, nil)
+	assert.NoError(t, err)
+
+	beat := match.ConfrontationChance(80, 70)
+	assert.Equal(t, math.Round(100*beat*beat)/100, outcome.XG, "beat the defender, then the goalkeeper")
+}
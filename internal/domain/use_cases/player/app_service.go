@@ -1,17 +1,28 @@
 package player
 
-import "github.com/robertobouses/online-football-tycoon/internal/domain"
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
 
 type Repository interface {
 	PostPlayer(player domain.Player) error
+	PostSquad(teamID uuid.UUID, players []domain.Player) error
+	GetTeamInjuries(teamID uuid.UUID) ([]domain.Player, error)
 }
 
-func NewApp(repository Repository) AppService {
+type NameGenerator interface {
+	GenerateName(country string) (string, string, error)
+}
+
+func NewApp(repository Repository, nameGenerator NameGenerator) AppService {
 	return AppService{
-		repo: repository,
+		repo:          repository,
+		nameGenerator: nameGenerator,
 	}
 }
 
 type AppService struct {
-	repo Repository
+	repo          Repository
+	nameGenerator NameGenerator
 }
@@ -10,7 +10,7 @@ import (
 
 func (a AppService) GeneratePlayer(country, position string) (domain.Player, error) {
 
-	firstName, lastName, err := GetRandomNameByCountry(country)
+	firstName, lastName, err := a.nameGenerator.GenerateName(country)
 	if err != nil {
 		return domain.Player{}, fmt.Errorf("error generating player name: %v", err)
 	}
@@ -32,7 +32,7 @@ func (a AppService) GeneratePlayer(country, position string) (domain.Player, err
 		InjuryDays:  injuryDays,
 		Lined:       false,
 		Familiarity: rand.Intn(80) + 1,
-		Fitness:     rand.Intn(100) + 1,
+		Fitness:     newPlayerFitness(),
 		Happiness:   rand.Intn(50) + 1,
 	}
 	log.Printf("Generated player: %+v\n", player)
@@ -46,3 +46,11 @@ func (a AppService) GeneratePlayer(country, position string) (domain.Player, err
 
 	return player, nil
 }
+
+// minNewPlayerFitness keeps new players well above the fitness a lineup
+// needs, so they join ready to play.
+const minNewPlayerFitness = 85
+
+func newPlayerFitness() int {
+	return minNewPlayerFitness + rand.Intn(100-minNewPlayerFitness+1)
+}
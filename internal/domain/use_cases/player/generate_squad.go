@@ -0,0 +1,151 @@
+package player
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+var squadComposition = []struct {
+	position string
+	count    int
+}{
+	{domain.PositionGoalkeeper, 3},
+	{domain.PositionDefender, 8},
+	{domain.PositionMidfielder, 7},
+	{domain.PositionForward, 5},
+}
+
+func (a AppService) GenerateSquad(teamID uuid.UUID, nationalities []domain.NationalityShare, quality domain.QualityBand) ([]domain.Player, error) {
+	if quality.Min < 1 || quality.Max > 100 || quality.Min > quality.Max {
+		return nil, fmt.Errorf("%w: %d-%d", domain.ErrInvalidQualityBand, quality.Min, quality.Max)
+	}
+
+	size := 0
+	for _, slot := range squadComposition {
+		size += slot.count
+	}
+
+	countries, err := squadNationalities(nationalities, size)
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]domain.Player, 0, size)
+	for _, slot := range squadComposition {
+		for i := 0; i < slot.count; i++ {
+			player, err := a.newSquadPlayer(countries[len(players)], slot.position, quality)
+			if err != nil {
+				return nil, err
+			}
+			players = append(players, player)
+		}
+	}
+
+	if err := a.repo.PostSquad(teamID, players); err != nil {
+		return nil, fmt.Errorf("error saving squad: %w", err)
+	}
+	log.Printf("Generated squad of %d players for team %s", len(players), teamID)
+
+	return players, nil
+}
+
+func (a AppService) newSquadPlayer(country, position string, quality domain.QualityBand) (domain.Player, error) {
+	firstName, lastName, err := a.nameGenerator.GenerateName(country)
+	if err != nil {
+		return domain.Player{}, fmt.Errorf("error generating player name: %w", err)
+	}
+
+	age, technique, mental, physique, injuryDays := CalculatePlayerAtributes()
+	technique, mental, physique = fitToQualityBand(technique, mental, physique, quality)
+	fee, salary := CalculatePlayerFeeAndSalary(technique, mental, physique, age, country, position)
+
+	return domain.Player{
+		PlayerId:    uuid.New(),
+		FirstName:   firstName,
+		LastName:    lastName,
+		Nationality: country,
+		Position:    position,
+		Age:         age,
+		Fee:         fee,
+		Salary:      salary,
+		Technique:   technique,
+		Mental:      mental,
+		Physique:    physique,
+		InjuryDays:  injuryDays,
+		Lined:       false,
+		Familiarity: rand.Intn(80) + 1,
+		Fitness:     newPlayerFitness(),
+		Happiness:   rand.Intn(50) + 1,
+	}, nil
+}
+
+// fitToQualityBand raises the weakest or lowers the strongest attribute one
+// point at a time until their average falls inside the band.
+func fitToQualityBand(technique, mental, physique int, quality domain.QualityBand) (int, int, int) {
+	attributes := []*int{&technique, &mental, &physique}
+	for {
+		sum := technique + mental + physique
+		switch {
+		case sum < quality.Min*3:
+			lowest := attributes[0]
+			for _, attribute := range attributes[1:] {
+				if *attribute < *lowest {
+					lowest = attribute
+				}
+			}
+			*lowest++
+		case sum > quality.Max*3:
+			highest := attributes[0]
+			for _, attribute := range attributes[1:] {
+				if *attribute > *highest {
+					highest = attribute
+				}
+			}
+			*highest--
+		default:
+			return technique, mental, physique
+		}
+	}
+}
+
+// squadNationalities spreads size players across the shares by weight using
+// the largest remainder method and shuffles the result.
+func squadNationalities(shares []domain.NationalityShare, size int) ([]string, error) {
+	totalWeight := 0
+	for _, share := range shares {
+		if share.Country == "" || share.Weight < 0 {
+			return nil, fmt.Errorf("%w: %+v", domain.ErrInvalidNationalities, share)
+		}
+		totalWeight += share.Weight
+	}
+	if totalWeight == 0 {
+		return nil, domain.ErrInvalidNationalities
+	}
+
+	countries := make([]string, 0, size)
+	order := make([]int, len(shares))
+	for i, share := range shares {
+		for n := 0; n < size*share.Weight/totalWeight; n++ {
+			countries = append(countries, share.Country)
+		}
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return size*shares[order[i]].Weight%totalWeight > size*shares[order[j]].Weight%totalWeight
+	})
+	for i := 0; len(countries) < size; i++ {
+		countries = append(countries, shares[order[i%len(order)]].Country)
+	}
+
+	rand.Shuffle(len(countries), func(i, j int) {
+		countries[i], countries[j] = countries[j], countries[i]
+	})
+
+	return countries, nil
+}
@@ -0,0 +1,94 @@
+package player_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/player"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) PostPlayer(player domain.Player) error {
+	args := m.Called(player)
+	return args.Error(0)
+}
+
+func (m *MockRepository) PostSquad(teamID uuid.UUID, players []domain.Player) error {
+	args := m.Called(teamID, players)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetTeamInjuries(teamID uuid.UUID) ([]domain.Player, error) {
+	args := m.Called(teamID)
+	players, _ := args.Get(0).([]domain.Player)
+	return players, args.Error(1)
+}
+
+type MockNameGenerator struct {
+	mock.Mock
+}
+
+func (m *MockNameGenerator) GenerateName(country string) (string, string, error) {
+	args := m.Called(country)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func TestGenerateSquadBuildsBalancedSquad(t *testing.T) {
+	teamID := uuid.New()
+	repo := new(MockRepository)
+	repo.On("PostSquad", teamID, mock.Anything).Return(nil)
+	names := new(MockNameGenerator)
+	names.On("GenerateName", mock.Anything).Return("Pablo", "García", nil)
+
+	service := player.NewApp(repo, names)
+
+	players, err := service.GenerateSquad(teamID, []domain.NationalityShare{
+		{Country: "ESP", Weight: 3},
+		{Country: "ARG", Weight: 1},
+	}, domain.QualityBand{Min: 70, Max: 80})
+
+	assert.NoError(t, err)
+	assert.Len(t, players, 23)
+
+	positions := map[string]int{}
+	nationalities := map[string]int{}
+	for _, p := range players {
+		positions[p.Position]++
+		nationalities[p.Nationality]++
+
+		average := (p.Technique + p.Mental + p.Physique) / 3
+		assert.GreaterOrEqual(t, average, 70)
+		assert.LessOrEqual(t, average, 80)
+		assert.NotEqual(t, uuid.Nil, p.PlayerId)
+		assert.GreaterOrEqual(t, p.Fitness, 85)
+		assert.LessOrEqual(t, p.Fitness, 100)
+	}
+	assert.Equal(t, map[string]int{
+		domain.PositionGoalkeeper: 3,
+		domain.PositionDefender:   8,
+		domain.PositionMidfielder: 7,
+		domain.PositionForward:    5,
+	}, positions)
+	assert.Equal(t, map[string]int{"ESP": 17, "ARG": 6}, nationalities)
+	repo.AssertExpectations(t)
+}
+
+func TestGenerateSquadValidatesInput(t *testing.T) {
+	repo := new(MockRepository)
+	service := player.NewApp(repo, new(MockNameGenerator))
+
+	_, err := service.GenerateSquad(uuid.New(), []domain.NationalityShare{{Country: "ESP", Weight: 1}}, domain.QualityBand{Min: 80, Max: 70})
+	assert.True(t, errors.Is(err, domain.ErrInvalidQualityBand))
+
+	_, err = service.GenerateSquad(uuid.New(), nil, domain.QualityBand{Min: 40, Max: 60})
+	assert.True(t, errors.Is(err, domain.ErrInvalidNationalities))
+
+	repo.AssertNotCalled(t, "PostSquad", mock.Anything, mock.Anything)
+}
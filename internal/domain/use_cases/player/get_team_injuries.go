@@ -0,0 +1,16 @@
+package player
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (a AppService) GetTeamInjuries(teamID uuid.UUID) ([]domain.Player, error) {
+	injured, err := a.repo.GetTeamInjuries(teamID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving injuries: %w", err)
+	}
+	return injured, nil
+}
@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const CheckpointName = "matchday"
+
+type MatchApp interface {
+	PlayMatch(seasonID, matchID uuid.UUID, seed *int64) (domain.Result, error)
+}
+
+type MatchRepository interface {
+	GetDueMatches(timestamp time.Time) ([]domain.SeasonMatch, error)
+}
+
+type CheckpointRepository interface {
+	GetCheckpoint(name string) (*domain.SchedulerCheckpoint, error)
+	SaveCheckpoint(checkpoint domain.SchedulerCheckpoint) error
+}
+
+type CupApp interface {
+	AdvanceRounds() error
+}
+
+type Config struct {
+	Interval   time.Duration
+	Workers    int
+	MaxRetries int
+	RetryDelay time.Duration
+}
+
+func NewApp(matchApp MatchApp, matchRepo MatchRepository, checkpointRepo CheckpointRepository, cupApp CupApp, config Config) AppService {
+	if config.Interval <= 0 {
+		config.Interval = time.Minute
+	}
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+	return AppService{
+		matchApp:       matchApp,
+		matchRepo:      matchRepo,
+		checkpointRepo: checkpointRepo,
+		cupApp:         cupApp,
+		config:         config,
+	}
+}
+
+type AppService struct {
+	matchApp       MatchApp
+	matchRepo      MatchRepository
+	checkpointRepo CheckpointRepository
+	cupApp         CupApp
+	config         Config
+}
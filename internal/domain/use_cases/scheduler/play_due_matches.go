@@ -0,0 +1,160 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+// Summary is what a run of the scheduler did with the due matches. Skipped
+// matches are left for the next run because an earlier match of one of their
+// teams failed.
+type Summary struct {
+	Played  int
+	Failed  int
+	Skipped int
+}
+
+// PlayDueMatches plays the due matches matchday by matchday, in the order of
+// their kick-off, so suspensions, injuries and fitness carry over from one
+// match of a team to the next. Only the matches of the same matchday are
+// played at the same time. Cup rounds left undrawn by an earlier run are drawn
+// first, and the run is saved as the scheduler checkpoint.
+func (a AppService) PlayDueMatches(ctx context.Context, now time.Time) (Summary, error) {
+	if err := a.cupApp.AdvanceRounds(); err != nil {
+		log.Printf("[scheduler] error drawing cup rounds: %v", err)
+	}
+
+	matches, err := a.matchRepo.GetDueMatches(now)
+	if err != nil {
+		return Summary{}, fmt.Errorf("error retrieving due matches: %w", err)
+	}
+
+	var summary Summary
+	failedTeams := make(map[uuid.UUID]bool)
+	for _, matchday := range matchdays(matches) {
+		if ctx.Err() != nil {
+			break
+		}
+
+		var playable []domain.SeasonMatch
+		for _, m := range matchday {
+			if failedTeams[m.HomeTeamID] || failedTeams[m.AwayTeamID] {
+				log.Printf("[scheduler] skipping match %s until the earlier matches of its teams are played", m.ID)
+				summary.Skipped++
+				continue
+			}
+			playable = append(playable, m)
+		}
+
+		started, failed := a.playMatchday(ctx, playable)
+		for _, m := range failed {
+			failedTeams[m.HomeTeamID] = true
+			failedTeams[m.AwayTeamID] = true
+		}
+		summary.Played += started - len(failed)
+		summary.Failed += len(failed)
+		summary.Skipped += len(playable) - started
+	}
+
+	log.Printf("[scheduler] run finished: due=%d played=%d failed=%d skipped=%d", len(matches), summary.Played, summary.Failed, summary.Skipped)
+
+	checkpoint := domain.SchedulerCheckpoint{
+		Name:      CheckpointName,
+		LastRunAt: now,
+		Played:    summary.Played,
+		Failed:    summary.Failed,
+		Skipped:   summary.Skipped,
+	}
+	if err := a.checkpointRepo.SaveCheckpoint(checkpoint); err != nil {
+		return summary, fmt.Errorf("error saving checkpoint: %w", err)
+	}
+
+	return summary, nil
+}
+
+// matchdays groups the due matches, sorted by kick-off, by their kick-off.
+func matchdays(matches []domain.SeasonMatch) [][]domain.SeasonMatch {
+	var groups [][]domain.SeasonMatch
+	for i, m := range matches {
+		if i == 0 || !m.MatchDate.Equal(matches[i-1].MatchDate) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], m)
+	}
+	return groups
+}
+
+// playMatchday plays the matches with the configured workers until the
+// context is done. It returns how many it started and those that failed.
+func (a AppService) playMatchday(ctx context.Context, matches []domain.SeasonMatch) (int, []domain.SeasonMatch) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var failed []domain.SeasonMatch
+	slots := make(chan struct{}, a.config.Workers)
+
+	started := 0
+	for _, m := range matches {
+		select {
+		case <-ctx.Done():
+		case slots <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		started++
+
+		wg.Add(1)
+		go func(m domain.SeasonMatch) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := a.playRecovered(ctx, m); err != nil {
+				log.Printf("[scheduler] match %s failed: %v", m.ID, err)
+				mu.Lock()
+				failed = append(failed, m)
+				mu.Unlock()
+			}
+		}(m)
+	}
+	wg.Wait()
+
+	return started, failed
+}
+
+// playRecovered turns a panic while playing a match into an error, so one
+// broken match is counted as failed instead of stopping the scheduler.
+func (a AppService) playRecovered(ctx context.Context, m domain.SeasonMatch) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic playing match: %v", r)
+		}
+	}()
+	return a.playWithRetry(ctx, m)
+}
+
+func (a AppService) playWithRetry(ctx context.Context, m domain.SeasonMatch) error {
+	var err error
+	for attempt := 0; attempt <= a.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			log.Printf("[scheduler] retrying match %s (attempt %d): %v", m.ID, attempt+1, err)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(a.config.RetryDelay * time.Duration(attempt)):
+			}
+		}
+
+		_, err = a.matchApp.PlayMatch(m.SeasonID, m.ID, nil)
+		if err == nil || errors.Is(err, domain.ErrMatchAlreadyPlayed) {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,247 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMatchApp struct {
+	mock.Mock
+}
+
+func (m *MockMatchApp) PlayMatch(seasonID, matchID uuid.UUID, seed *int64) (domain.Result, error) {
+	args := m.Called(seasonID, matchID)
+	return domain.Result{}, args.Error(0)
+}
+
+type MockMatchRepository struct {
+	mock.Mock
+}
+
+func (m *MockMatchRepository) GetDueMatches(timestamp time.Time) ([]domain.SeasonMatch, error) {
+	args := m.Called(timestamp)
+	return args.Get(0).([]domain.SeasonMatch), args.Error(1)
+}
+
+type MockCheckpointRepository struct {
+	mock.Mock
+}
+
+func (m *MockCheckpointRepository) GetCheckpoint(name string) (*domain.SchedulerCheckpoint, error) {
+	args := m.Called(name)
+	checkpoint, _ := args.Get(0).(*domain.SchedulerCheckpoint)
+	return checkpoint, args.Error(1)
+}
+
+func (m *MockCheckpointRepository) SaveCheckpoint(checkpoint domain.SchedulerCheckpoint) error {
+	args := m.Called(checkpoint)
+	return args.Error(0)
+}
+
+func savingCheckpoints() *MockCheckpointRepository {
+	checkpointRepo := new(MockCheckpointRepository)
+	checkpointRepo.On("SaveCheckpoint", mock.Anything).Return(nil)
+	return checkpointRepo
+}
+
+type MockCupApp struct {
+	mock.Mock
+}
+
+func (m *MockCupApp) AdvanceRounds() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func TestPlayDueMatchesRetries(t *testing.T) {
+	now := time.Date(2025, 7, 13, 12, 0, 0, 0, time.UTC)
+	seasonID := uuid.New()
+	flaky := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID}
+	broken := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID}
+	replayed := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID}
+
+	matchRepo := new(MockMatchRepository)
+	matchRepo.On("GetDueMatches", now).Return([]domain.SeasonMatch{flaky, broken, replayed}, nil)
+
+	matchApp := new(MockMatchApp)
+	matchApp.On("PlayMatch", seasonID, flaky.ID).Return(errors.New("connection reset")).Once()
+	matchApp.On("PlayMatch", seasonID, flaky.ID).Return(nil).Once()
+	matchApp.On("PlayMatch", seasonID, broken.ID).Return(errors.New("no lineup")).Twice()
+	matchApp.On("PlayMatch", seasonID, replayed.ID).Return(domain.ErrMatchAlreadyPlayed).Once()
+
+	cupApp := new(MockCupApp)
+	cupApp.On("AdvanceRounds").Return(nil)
+
+	checkpointRepo := new(MockCheckpointRepository)
+	checkpointRepo.On("SaveCheckpoint", domain.SchedulerCheckpoint{
+		Name:      scheduler.CheckpointName,
+		LastRunAt: now,
+		Played:    2,
+		Failed:    1,
+	}).Return(nil)
+
+	service := scheduler.NewApp(matchApp, matchRepo, checkpointRepo, cupApp, scheduler.Config{Workers: 2, MaxRetries: 1})
+
+	summary, err := service.PlayDueMatches(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.Summary{Played: 2, Failed: 1}, summary)
+	matchRepo.AssertExpectations(t)
+	matchApp.AssertExpectations(t)
+	checkpointRepo.AssertExpectations(t)
+}
+
+func TestPlayDueMatchesSurvivesAPanickingMatch(t *testing.T) {
+	now := time.Date(2025, 7, 13, 12, 0, 0, 0, time.UTC)
+	seasonID := uuid.New()
+	broken := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID, HomeTeamID: uuid.New(), AwayTeamID: uuid.New(), MatchDate: now}
+	other := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID, HomeTeamID: uuid.New(), AwayTeamID: uuid.New(), MatchDate: now}
+
+	matchRepo := new(MockMatchRepository)
+	matchRepo.On("GetDueMatches", now).Return([]domain.SeasonMatch{broken, other}, nil)
+
+	matchApp := new(MockMatchApp)
+	matchApp.On("PlayMatch", seasonID, broken.ID).Return(nil).Run(func(mock.Arguments) { panic("nil defender") }).Once()
+	matchApp.On("PlayMatch", seasonID, other.ID).Return(nil).Once()
+
+	cupApp := new(MockCupApp)
+	cupApp.On("AdvanceRounds").Return(nil)
+
+	service := scheduler.NewApp(matchApp, matchRepo, savingCheckpoints(), cupApp, scheduler.Config{Workers: 2, MaxRetries: 1})
+
+	summary, err := service.PlayDueMatches(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.Summary{Played: 1, Failed: 1}, summary)
+	matchApp.AssertExpectations(t)
+}
+
+func TestPlayDueMatchesReportsACheckpointError(t *testing.T) {
+	now := time.Date(2025, 7, 13, 12, 0, 0, 0, time.UTC)
+
+	matchRepo := new(MockMatchRepository)
+	matchRepo.On("GetDueMatches", now).Return([]domain.SeasonMatch{}, nil)
+
+	cupApp := new(MockCupApp)
+	cupApp.On("AdvanceRounds").Return(nil)
+
+	checkpointRepo := new(MockCheckpointRepository)
+	checkpointRepo.On("SaveCheckpoint", mock.Anything).Return(errors.New("connection refused"))
+
+	service := scheduler.NewApp(new(MockMatchApp), matchRepo, checkpointRepo, cupApp, scheduler.Config{})
+
+	_, err := service.PlayDueMatches(context.Background(), now)
+
+	assert.Error(t, err)
+}
+
+func TestRunWaitsForTheIntervalSinceTheLastRun(t *testing.T) {
+	checkpointRepo := new(MockCheckpointRepository)
+	checkpointRepo.On("GetCheckpoint", scheduler.CheckpointName).Return(&domain.SchedulerCheckpoint{
+		Name:      scheduler.CheckpointName,
+		LastRunAt: time.Now(),
+	}, nil)
+
+	matchRepo := new(MockMatchRepository)
+	service := scheduler.NewApp(new(MockMatchApp), matchRepo, checkpointRepo, new(MockCupApp), scheduler.Config{Interval: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	assert.NoError(t, service.Run(ctx))
+	checkpointRepo.AssertExpectations(t)
+	matchRepo.AssertNotCalled(t, "GetDueMatches", mock.Anything)
+}
+
+func TestRunStopsWhenTheCheckpointCannotBeRead(t *testing.T) {
+	checkpointRepo := new(MockCheckpointRepository)
+	checkpointRepo.On("GetCheckpoint", scheduler.CheckpointName).Return(nil, errors.New("connection refused"))
+
+	service := scheduler.NewApp(new(MockMatchApp), new(MockMatchRepository), checkpointRepo, new(MockCupApp), scheduler.Config{})
+
+	assert.Error(t, service.Run(context.Background()))
+}
+
+func TestPlayDueMatchesPlaysMatchdaysInOrder(t *testing.T) {
+	now := time.Date(2025, 7, 13, 12, 0, 0, 0, time.UTC)
+	seasonID := uuid.New()
+	alpha, beta, gamma, delta := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	first, second := now.AddDate(0, 0, -14), now.AddDate(0, 0, -7)
+
+	broken := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID, HomeTeamID: alpha, AwayTeamID: beta, MatchDate: first}
+	played := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID, HomeTeamID: gamma, AwayTeamID: delta, MatchDate: first}
+	skipped := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID, HomeTeamID: beta, AwayTeamID: gamma, MatchDate: second}
+	next := domain.SeasonMatch{ID: uuid.New(), SeasonID: seasonID, HomeTeamID: delta, AwayTeamID: uuid.New(), MatchDate: second}
+
+	matchRepo := new(MockMatchRepository)
+	matchRepo.On("GetDueMatches", now).Return([]domain.SeasonMatch{broken, played, skipped, next}, nil)
+
+	var order []uuid.UUID
+	matchApp := new(MockMatchApp)
+	for _, m := range []domain.SeasonMatch{played, next} {
+		matchApp.On("PlayMatch", seasonID, m.ID).Return(nil).Run(func(mock.Arguments) { order = append(order, m.ID) }).Once()
+	}
+	matchApp.On("PlayMatch", seasonID, broken.ID).Return(errors.New("no lineup")).Once()
+
+	cupApp := new(MockCupApp)
+	cupApp.On("AdvanceRounds").Return(nil)
+
+	service := scheduler.NewApp(matchApp, matchRepo, savingCheckpoints(), cupApp, scheduler.Config{Workers: 1})
+
+	summary, err := service.PlayDueMatches(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.Summary{Played: 2, Failed: 1, Skipped: 1}, summary)
+	assert.Equal(t, []uuid.UUID{played.ID, next.ID}, order)
+	matchApp.AssertExpectations(t)
+	matchApp.AssertNotCalled(t, "PlayMatch", seasonID, skipped.ID)
+}
+
+func TestPlayDueMatchesStopsWhenCancelled(t *testing.T) {
+	now := time.Date(2025, 7, 13, 12, 0, 0, 0, time.UTC)
+	due := []domain.SeasonMatch{{ID: uuid.New(), MatchDate: now}, {ID: uuid.New(), MatchDate: now}}
+
+	matchRepo := new(MockMatchRepository)
+	matchRepo.On("GetDueMatches", now).Return(due, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	matchApp := new(MockMatchApp)
+	matchApp.On("PlayMatch", mock.Anything, mock.Anything).Return(nil).Run(func(mock.Arguments) { cancel() }).Once()
+
+	cupApp := new(MockCupApp)
+	cupApp.On("AdvanceRounds").Return(nil)
+
+	service := scheduler.NewApp(matchApp, matchRepo, savingCheckpoints(), cupApp, scheduler.Config{Workers: 1})
+
+	summary, err := service.PlayDueMatches(ctx, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.Summary{Played: 1, Skipped: 1}, summary)
+	matchApp.AssertExpectations(t)
+}
+
+func TestPlayDueMatchesDrawsPendingCupRounds(t *testing.T) {
+	now := time.Date(2025, 7, 13, 12, 0, 0, 0, time.UTC)
+
+	matchRepo := new(MockMatchRepository)
+	matchRepo.On("GetDueMatches", now).Return([]domain.SeasonMatch{}, nil)
+
+	cupApp := new(MockCupApp)
+	cupApp.On("AdvanceRounds").Return(errors.New("duplicate round")).Once()
+
+	service := scheduler.NewApp(new(MockMatchApp), matchRepo, savingCheckpoints(), cupApp, scheduler.Config{})
+
+	summary, err := service.PlayDueMatches(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, scheduler.Summary{}, summary)
+	cupApp.AssertExpectations(t)
+}
@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Run plays the due matches every interval until the context is done. After a
+// restart the first run waits for what is left of the interval since the last
+// run saved in the checkpoint.
+func (a AppService) Run(ctx context.Context) error {
+	checkpoint, err := a.checkpointRepo.GetCheckpoint(CheckpointName)
+	if err != nil {
+		return fmt.Errorf("error reading checkpoint: %w", err)
+	}
+	if checkpoint != nil {
+		log.Printf("[scheduler] last run at %s: played=%d failed=%d skipped=%d", checkpoint.LastRunAt.Format(time.RFC3339), checkpoint.Played, checkpoint.Failed, checkpoint.Skipped)
+		if wait := a.config.Interval - time.Since(checkpoint.LastRunAt); wait > 0 {
+			select {
+			case <-ctx.Done():
+				log.Println("[scheduler] stopped")
+				return nil
+			case <-time.After(wait):
+			}
+		}
+	}
+
+	log.Printf("[scheduler] started: interval=%s workers=%d retries=%d", a.config.Interval, a.config.Workers, a.config.MaxRetries)
+
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.PlayDueMatches(ctx, time.Now()); err != nil {
+			log.Printf("[scheduler] run failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Println("[scheduler] stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
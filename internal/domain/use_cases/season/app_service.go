@@ -0,0 +1,70 @@
+package season
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/classification"
+)
+
+type Repository interface {
+	GetSeason(seasonID uuid.UUID) (domain.Season, error)
+	GetOpenSeasons(countryCode string, fromDate time.Time) ([]domain.Season, error)
+	PostSeasonTransition(closedSeasons []uuid.UUID, nextSeasons []domain.Season, seasonTeams map[uuid.UUID][]uuid.UUID) error
+}
+
+type TournamentRepository interface {
+	GetTournamentBySeasonID(seasonID uuid.UUID) (domain.Tournament, error)
+}
+
+type TeamRepository interface {
+	GetSeasonTeam(seasonID uuid.UUID) ([]uuid.UUID, error)
+}
+
+type MatchRepository interface {
+	GetSeasonMatches(seasonID uuid.UUID) ([]domain.SeasonMatch, error)
+}
+
+type ClassificationApp interface {
+	GetClassification(seasonID uuid.UUID) ([]classification.Classification, error)
+}
+
+type CupApp interface {
+	GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error)
+	GeneratePlayoff(seasonID uuid.UUID, rankedTeamIDs []uuid.UUID, startDate time.Time) error
+}
+
+type FixtureApp interface {
+	GenerateSeason(seasonID uuid.UUID, startDate time.Time) error
+}
+
+func NewApp(
+	repository Repository,
+	tournamentRepository TournamentRepository,
+	teamRepository TeamRepository,
+	matchRepository MatchRepository,
+	classificationApp ClassificationApp,
+	cupApp CupApp,
+	fixtureApp FixtureApp,
+) AppService {
+	return AppService{
+		repo:              repository,
+		tournamentRepo:    tournamentRepository,
+		teamRepo:          teamRepository,
+		matchRepo:         matchRepository,
+		classificationApp: classificationApp,
+		cupApp:            cupApp,
+		fixtureApp:        fixtureApp,
+	}
+}
+
+type AppService struct {
+	repo              Repository
+	tournamentRepo    TournamentRepository
+	teamRepo          TeamRepository
+	matchRepo         MatchRepository
+	classificationApp ClassificationApp
+	cupApp            CupApp
+	fixtureApp        FixtureApp
+}
@@ -0,0 +1,271 @@
+package season
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+const playoffDaysAfterSeason = 7
+
+type closingSeason struct {
+	season     domain.Season
+	tournament domain.Tournament
+	teams      []uuid.UUID
+	standings  []uuid.UUID
+	lastMatch  time.Time
+}
+
+func (a AppService) EndSeason(seasonID uuid.UUID) (domain.SeasonTransition, error) {
+	season, err := a.repo.GetSeason(seasonID)
+	if err != nil {
+		return domain.SeasonTransition{}, fmt.Errorf("error retrieving season: %w", err)
+	}
+
+	tournament, err := a.tournamentRepo.GetTournamentBySeasonID(seasonID)
+	if err != nil {
+		return domain.SeasonTransition{}, fmt.Errorf("error retrieving tournament: %w", err)
+	}
+
+	if season.Closed {
+		return a.resumeTransition(season, tournament.CountryCode)
+	}
+
+	seasons, err := a.repo.GetOpenSeasons(tournament.CountryCode, season.FromDate)
+	if err != nil {
+		return domain.SeasonTransition{}, fmt.Errorf("error retrieving open seasons: %w", err)
+	}
+
+	closing := make([]closingSeason, 0, len(seasons))
+	openTournaments := make(map[uuid.UUID]bool, len(seasons))
+	for _, s := range seasons {
+		cs, err := a.loadClosingSeason(s)
+		if err != nil {
+			return domain.SeasonTransition{}, err
+		}
+		openTournaments[cs.tournament.ID] = true
+		closing = append(closing, cs)
+	}
+
+	var transition domain.SeasonTransition
+	for _, cs := range closing {
+		if cs.tournament.Type != domain.TournamentLeague {
+			continue
+		}
+
+		if cs.tournament.PromotionTo != nil {
+			promoted, pending, err := a.promotedTeams(cs)
+			if err != nil {
+				return domain.SeasonTransition{}, err
+			}
+			if pending {
+				log.Printf("Season %s has promotion play-offs pending", cs.season.ID)
+				transition.PlayoffsPending = true
+				continue
+			}
+			transition.Moves = append(transition.Moves, newMoves(promoted, cs.tournament.ID, *cs.tournament.PromotionTo)...)
+		}
+
+		if cs.tournament.DescentTo != nil {
+			transition.Moves = append(transition.Moves, newMoves(relegatedTeams(cs), cs.tournament.ID, *cs.tournament.DescentTo)...)
+		}
+	}
+
+	if transition.PlayoffsPending {
+		transition.Moves = nil
+		return transition, nil
+	}
+
+	for _, move := range transition.Moves {
+		if !openTournaments[move.ToTournamentID] {
+			return domain.SeasonTransition{}, fmt.Errorf("no open season for tournament %s to receive team %s", move.ToTournamentID, move.TeamID)
+		}
+	}
+
+	seasonTeams := make(map[uuid.UUID][]uuid.UUID, len(closing))
+	for _, cs := range closing {
+		next := domain.Season{
+			ID:           uuid.New(),
+			TournamentID: cs.tournament.ID,
+			FromDate:     cs.season.FromDate.AddDate(1, 0, 0),
+			ToDate:       cs.season.ToDate.AddDate(1, 0, 0),
+		}
+		seasonTeams[next.ID] = nextTeams(cs, transition.Moves)
+		transition.ClosedSeasons = append(transition.ClosedSeasons, cs.season.ID)
+		transition.NextSeasons = append(transition.NextSeasons, next)
+	}
+
+	if err := a.repo.PostSeasonTransition(transition.ClosedSeasons, transition.NextSeasons, seasonTeams); err != nil {
+		return domain.SeasonTransition{}, fmt.Errorf("error saving season transition: %w", err)
+	}
+
+	if err := a.generateFixtures(transition.NextSeasons); err != nil {
+		return transition, err
+	}
+
+	return transition, nil
+}
+
+// resumeTransition generates the fixtures of the next seasons a transition
+// left without them, as when generating them failed after the seasons were
+// closed. Once every next season has its fixtures the season stays closed.
+func (a AppService) resumeTransition(season domain.Season, countryCode string) (domain.SeasonTransition, error) {
+	nextSeasons, err := a.repo.GetOpenSeasons(countryCode, season.FromDate.AddDate(1, 0, 0))
+	if err != nil {
+		return domain.SeasonTransition{}, fmt.Errorf("error retrieving next seasons: %w", err)
+	}
+
+	var transition domain.SeasonTransition
+	for _, next := range nextSeasons {
+		matches, err := a.matchRepo.GetSeasonMatches(next.ID)
+		if err != nil {
+			return domain.SeasonTransition{}, fmt.Errorf("error retrieving matches for season %s: %w", next.ID, err)
+		}
+		if len(matches) == 0 {
+			transition.NextSeasons = append(transition.NextSeasons, next)
+		}
+	}
+	if len(transition.NextSeasons) == 0 {
+		return domain.SeasonTransition{}, fmt.Errorf("season %s: %w", season.ID, domain.ErrSeasonClosed)
+	}
+
+	log.Printf("Season %s is closed, generating the missing fixtures of %d next seasons", season.ID, len(transition.NextSeasons))
+	if err := a.generateFixtures(transition.NextSeasons); err != nil {
+		return transition, err
+	}
+
+	return transition, nil
+}
+
+func (a AppService) generateFixtures(seasons []domain.Season) error {
+	for _, next := range seasons {
+		if err := a.fixtureApp.GenerateSeason(next.ID, next.FromDate); err != nil {
+			return fmt.Errorf("error generating fixtures for season %s: %w", next.ID, err)
+		}
+	}
+	return nil
+}
+
+func (a AppService) loadClosingSeason(season domain.Season) (closingSeason, error) {
+	tournament, err := a.tournamentRepo.GetTournamentBySeasonID(season.ID)
+	if err != nil {
+		return closingSeason{}, fmt.Errorf("error retrieving tournament for season %s: %w", season.ID, err)
+	}
+
+	teams, err := a.teamRepo.GetSeasonTeam(season.ID)
+	if err != nil {
+		return closingSeason{}, fmt.Errorf("error retrieving teams for season %s: %w", season.ID, err)
+	}
+
+	matches, err := a.matchRepo.GetSeasonMatches(season.ID)
+	if err != nil {
+		return closingSeason{}, fmt.Errorf("error retrieving matches for season %s: %w", season.ID, err)
+	}
+
+	cs := closingSeason{
+		season:     season,
+		tournament: tournament,
+		teams:      teams,
+	}
+	for _, m := range matches {
+		isPlayoff := tournament.Type == domain.TournamentLeague && m.CupTieID != nil
+		if isPlayoff {
+			continue
+		}
+		if m.HomeResult == nil || m.AwayResult == nil {
+			return closingSeason{}, fmt.Errorf("season %s: %w", season.ID, domain.ErrSeasonNotFinished)
+		}
+		if m.MatchDate.After(cs.lastMatch) {
+			cs.lastMatch = m.MatchDate
+		}
+	}
+
+	if tournament.Type == domain.TournamentLeague {
+		classification, err := a.classificationApp.GetClassification(season.ID)
+		if err != nil {
+			return closingSeason{}, fmt.Errorf("error retrieving classification for season %s: %w", season.ID, err)
+		}
+		for _, c := range classification {
+			for _, team := range c.Teams {
+				cs.standings = append(cs.standings, team.TeamID)
+			}
+		}
+	}
+
+	return cs, nil
+}
+
+func (a AppService) promotedTeams(cs closingSeason) ([]uuid.UUID, bool, error) {
+	direct := min(cs.tournament.PromotionSpots, len(cs.standings))
+	promoted := append([]uuid.UUID{}, cs.standings[:direct]...)
+
+	contenders := cs.standings[direct:min(direct+cs.tournament.PlayoffSpots, len(cs.standings))]
+	if len(contenders) < 2 {
+		return append(promoted, contenders...), false, nil
+	}
+
+	ties, err := a.cupApp.GetCupTies(cs.season.ID)
+	if err != nil {
+		return nil, false, fmt.Errorf("error retrieving play-off ties: %w", err)
+	}
+	if len(ties) == 0 {
+		if err := a.cupApp.GeneratePlayoff(cs.season.ID, contenders, cs.lastMatch.AddDate(0, 0, playoffDaysAfterSeason)); err != nil {
+			return nil, false, fmt.Errorf("error generating play-off: %w", err)
+		}
+		return nil, true, nil
+	}
+
+	final := ties[len(ties)-1]
+	finalRoundTies := 0
+	for _, tie := range ties {
+		if tie.Round == final.Round {
+			finalRoundTies++
+		}
+	}
+	if finalRoundTies != 1 || final.WinnerTeamID == nil {
+		return nil, true, nil
+	}
+
+	return append(promoted, *final.WinnerTeamID), false, nil
+}
+
+func relegatedTeams(cs closingSeason) []uuid.UUID {
+	spots := min(cs.tournament.RelegationSpots, len(cs.standings))
+	return cs.standings[len(cs.standings)-spots:]
+}
+
+func newMoves(teamIDs []uuid.UUID, from, to uuid.UUID) []domain.TeamMove {
+	moves := make([]domain.TeamMove, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		moves = append(moves, domain.TeamMove{
+			TeamID:           teamID,
+			FromTournamentID: from,
+			ToTournamentID:   to,
+		})
+	}
+	return moves
+}
+
+func nextTeams(cs closingSeason, moves []domain.TeamMove) []uuid.UUID {
+	leaving := make(map[uuid.UUID]bool)
+	var arriving []uuid.UUID
+	for _, move := range moves {
+		if move.FromTournamentID == cs.tournament.ID {
+			leaving[move.TeamID] = true
+		}
+		if move.ToTournamentID == cs.tournament.ID {
+			arriving = append(arriving, move.TeamID)
+		}
+	}
+
+	teams := make([]uuid.UUID, 0, len(cs.teams))
+	for _, teamID := range cs.teams {
+		if !leaving[teamID] {
+			teams = append(teams, teamID)
+		}
+	}
+	return append(teams, arriving...)
+}
@@ -0,0 +1,254 @@
+package season_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/classification"
+	"github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/season"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetSeason(seasonID uuid.UUID) (domain.Season, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).(domain.Season), args.Error(1)
+}
+
+func (m *MockRepository) GetOpenSeasons(countryCode string, fromDate time.Time) ([]domain.Season, error) {
+	args := m.Called(countryCode, fromDate)
+	return args.Get(0).([]domain.Season), args.Error(1)
+}
+
+func (m *MockRepository) PostSeasonTransition(closedSeasons []uuid.UUID, nextSeasons []domain.Season, seasonTeams map[uuid.UUID][]uuid.UUID) error {
+	args := m.Called(closedSeasons, nextSeasons, seasonTeams)
+	return args.Error(0)
+}
+
+type MockTournamentRepository struct {
+	mock.Mock
+}
+
+func (m *MockTournamentRepository) GetTournamentBySeasonID(seasonID uuid.UUID) (domain.Tournament, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).(domain.Tournament), args.Error(1)
+}
+
+type MockTeamRepository struct {
+	mock.Mock
+}
+
+func (m *MockTeamRepository) GetSeasonTeam(seasonID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+type MockMatchRepository struct {
+	mock.Mock
+}
+
+func (m *MockMatchRepository) GetSeasonMatches(seasonID uuid.UUID) ([]domain.SeasonMatch, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).([]domain.SeasonMatch), args.Error(1)
+}
+
+type MockClassificationApp struct {
+	mock.Mock
+}
+
+func (m *MockClassificationApp) GetClassification(seasonID uuid.UUID) ([]classification.Classification, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).([]classification.Classification), args.Error(1)
+}
+
+type MockCupApp struct {
+	mock.Mock
+}
+
+func (m *MockCupApp) GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error) {
+	args := m.Called(seasonID)
+	return args.Get(0).([]domain.CupTie), args.Error(1)
+}
+
+func (m *MockCupApp) GeneratePlayoff(seasonID uuid.UUID, rankedTeamIDs []uuid.UUID, startDate time.Time) error {
+	args := m.Called(seasonID, rankedTeamIDs, startDate)
+	return args.Error(0)
+}
+
+type MockFixtureApp struct {
+	mock.Mock
+}
+
+func (m *MockFixtureApp) GenerateSeason(seasonID uuid.UUID, startDate time.Time) error {
+	args := m.Called(seasonID, startDate)
+	return args.Error(0)
+}
+
+type league struct {
+	season     domain.Season
+	tournament domain.Tournament
+	teams      []uuid.UUID
+}
+
+type fixture struct {
+	repo              *MockRepository
+	tournamentRepo    *MockTournamentRepository
+	teamRepo          *MockTeamRepository
+	matchRepo         *MockMatchRepository
+	classificationApp *MockClassificationApp
+	cupApp            *MockCupApp
+	fixtureApp        *MockFixtureApp
+	top               league
+	second            league
+}
+
+func newFixture(lastResult *int) fixture {
+	from := time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)
+	topID, secondID := uuid.New(), uuid.New()
+
+	f := fixture{
+		repo:              new(MockRepository),
+		tournamentRepo:    new(MockTournamentRepository),
+		teamRepo:          new(MockTeamRepository),
+		matchRepo:         new(MockMatchRepository),
+		classificationApp: new(MockClassificationApp),
+		cupApp:            new(MockCupApp),
+		fixtureApp:        new(MockFixtureApp),
+		top: league{
+			season:     domain.Season{ID: uuid.New(), TournamentID: topID, FromDate: from, ToDate: to},
+			tournament: domain.Tournament{ID: topID, Type: domain.TournamentLeague, CountryCode: "ESP", DescentTo: &secondID, RelegationSpots: 1},
+			teams:      []uuid.UUID{uuid.New(), uuid.New(), uuid.New()},
+		},
+		second: league{
+			season:     domain.Season{ID: uuid.New(), TournamentID: secondID, FromDate: from, ToDate: to},
+			tournament: domain.Tournament{ID: secondID, Type: domain.TournamentLeague, CountryCode: "ESP", PromotionTo: &topID, PromotionSpots: 1},
+			teams:      []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()},
+		},
+	}
+
+	f.repo.On("GetSeason", f.top.season.ID).Return(f.top.season, nil)
+	f.repo.On("GetOpenSeasons", "ESP", from).Return([]domain.Season{f.top.season, f.second.season}, nil)
+
+	for _, l := range []league{f.top, f.second} {
+		f.tournamentRepo.On("GetTournamentBySeasonID", l.season.ID).Return(l.tournament, nil)
+		f.teamRepo.On("GetSeasonTeam", l.season.ID).Return(l.teams, nil)
+
+		result := 1
+		f.matchRepo.On("GetSeasonMatches", l.season.ID).Return([]domain.SeasonMatch{
+			{ID: uuid.New(), SeasonID: l.season.ID, HomeTeamID: l.teams[0], AwayTeamID: l.teams[1], HomeResult: &result, AwayResult: lastResult, MatchDate: to},
+		}, nil)
+
+		var standings []classification.TeamClassification
+		for i, teamID := range l.teams {
+			standings = append(standings, classification.TeamClassification{TeamID: teamID, Position: i + 1})
+		}
+		f.classificationApp.On("GetClassification", l.season.ID).Return([]classification.Classification{{Teams: standings}}, nil)
+	}
+
+	return f
+}
+
+func (f fixture) app() season.AppService {
+	return season.NewApp(f.repo, f.tournamentRepo, f.teamRepo, f.matchRepo, f.classificationApp, f.cupApp, f.fixtureApp)
+}
+
+func TestEndSeasonPromotesAndRelegatesTeams(t *testing.T) {
+	result := 0
+	f := newFixture(&result)
+
+	f.repo.On("PostSeasonTransition",
+		[]uuid.UUID{f.top.season.ID, f.second.season.ID},
+		mock.Anything,
+		mock.MatchedBy(func(seasonTeams map[uuid.UUID][]uuid.UUID) bool { return len(seasonTeams) == 2 }),
+	).Return(nil)
+	f.fixtureApp.On("GenerateSeason", mock.Anything, time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC)).Return(nil).Twice()
+
+	transition, err := f.app().EndSeason(f.top.season.ID)
+
+	assert.NoError(t, err)
+	assert.False(t, transition.PlayoffsPending)
+	assert.ElementsMatch(t, []domain.TeamMove{
+		{TeamID: f.second.teams[0], FromTournamentID: f.second.tournament.ID, ToTournamentID: f.top.tournament.ID},
+		{TeamID: f.top.teams[2], FromTournamentID: f.top.tournament.ID, ToTournamentID: f.second.tournament.ID},
+	}, transition.Moves)
+
+	seasonTeams := f.repo.Calls[len(f.repo.Calls)-1].Arguments.Get(2).(map[uuid.UUID][]uuid.UUID)
+	for _, next := range transition.NextSeasons {
+		switch next.TournamentID {
+		case f.top.tournament.ID:
+			assert.Equal(t, []uuid.UUID{f.top.teams[0], f.top.teams[1], f.second.teams[0]}, seasonTeams[next.ID])
+		case f.second.tournament.ID:
+			assert.Equal(t, []uuid.UUID{f.second.teams[1], f.second.teams[2], f.second.teams[3], f.top.teams[2]}, seasonTeams[next.ID])
+		}
+	}
+	f.fixtureApp.AssertExpectations(t)
+}
+
+func TestEndSeasonSchedulesPlayoffsFirst(t *testing.T) {
+	result := 0
+	f := newFixture(&result)
+	f.second.tournament.PromotionSpots = 0
+	f.second.tournament.PlayoffSpots = 2
+	f.tournamentRepo.ExpectedCalls = nil
+	f.tournamentRepo.On("GetTournamentBySeasonID", f.top.season.ID).Return(f.top.tournament, nil)
+	f.tournamentRepo.On("GetTournamentBySeasonID", f.second.season.ID).Return(f.second.tournament, nil)
+
+	f.cupApp.On("GetCupTies", f.second.season.ID).Return([]domain.CupTie{}, nil)
+	f.cupApp.On("GeneratePlayoff", f.second.season.ID, f.second.teams[:2], time.Date(2026, 6, 7, 0, 0, 0, 0, time.UTC)).Return(nil)
+
+	transition, err := f.app().EndSeason(f.top.season.ID)
+
+	assert.NoError(t, err)
+	assert.True(t, transition.PlayoffsPending)
+	assert.Empty(t, transition.Moves)
+	f.cupApp.AssertExpectations(t)
+	f.repo.AssertNotCalled(t, "PostSeasonTransition", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEndSeasonRejectsUnfinishedSeason(t *testing.T) {
+	f := newFixture(nil)
+
+	_, err := f.app().EndSeason(f.top.season.ID)
+
+	assert.True(t, errors.Is(err, domain.ErrSeasonNotFinished))
+	f.repo.AssertNotCalled(t, "PostSeasonTransition", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEndSeasonResumesMissingFixtures(t *testing.T) {
+	result := 0
+	f := newFixture(&result)
+	closed := f.top.season
+	closed.Closed = true
+	f.repo.ExpectedCalls = nil
+	f.repo.On("GetSeason", closed.ID).Return(closed, nil)
+
+	nextFrom := time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC)
+	scheduled := domain.Season{ID: uuid.New(), FromDate: nextFrom}
+	missing := domain.Season{ID: uuid.New(), FromDate: nextFrom}
+	f.repo.On("GetOpenSeasons", "ESP", nextFrom).Return([]domain.Season{scheduled, missing}, nil)
+	f.matchRepo.On("GetSeasonMatches", scheduled.ID).Return([]domain.SeasonMatch{{ID: uuid.New()}}, nil)
+	f.matchRepo.On("GetSeasonMatches", missing.ID).Return([]domain.SeasonMatch{}, nil)
+	f.fixtureApp.On("GenerateSeason", missing.ID, nextFrom).Return(nil).Once()
+
+	transition, err := f.app().EndSeason(closed.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Season{missing}, transition.NextSeasons)
+	f.fixtureApp.AssertExpectations(t)
+
+	f.matchRepo.ExpectedCalls = nil
+	f.matchRepo.On("GetSeasonMatches", mock.Anything).Return([]domain.SeasonMatch{{ID: uuid.New()}}, nil)
+
+	_, err = f.app().EndSeason(closed.ID)
+
+	assert.True(t, errors.Is(err, domain.ErrSeasonClosed))
+	f.fixtureApp.AssertNumberOfCalls(t, "GenerateSeason", 1)
+}
@@ -1,6 +1,8 @@
 package team
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/match"
 	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/repository/tournament"
@@ -10,11 +12,16 @@ type Repository interface {
 	GetSeasonTeam(seasonID uuid.UUID) ([]uuid.UUID, error)
 }
 
-func NewApp(repository Repository, matchRepo match.Repository, tournamentRepo tournament.Repository) AppService {
+type CupApp interface {
+	GenerateFirstRound(seasonID uuid.UUID, teamIDs []uuid.UUID, startDate time.Time) error
+}
+
+func NewApp(repository Repository, matchRepo match.Repository, tournamentRepo tournament.Repository, cupApp CupApp) AppService {
 	return AppService{
 		repo:           repository,
 		matchRepo:      matchRepo,
 		tournamentRepo: tournamentRepo,
+		cupApp:         cupApp,
 	}
 }
 
@@ -22,4 +29,5 @@ type AppService struct {
 	repo           Repository
 	matchRepo      match.Repository
 	tournamentRepo tournament.Repository
+	cupApp         CupApp
 }
@@ -1,7 +1,6 @@
 package team
 
 import (
-	"math/rand/v2"
 	"time"
 
 	"github.com/google/uuid"
@@ -25,7 +24,7 @@ func (a AppService) GenerateSeason(seasonID uuid.UUID, startDate time.Time) erro
 		matches = generateLeague(seasonID, teamIDs, startDate)
 
 	case domain.TournamentCup:
-		matches = generateCup(seasonID, teamIDs, startDate)
+		return a.cupApp.GenerateFirstRound(seasonID, teamIDs, startDate)
 	}
 
 	return a.matchRepo.PostMatches(matches)
@@ -72,23 +71,3 @@ func generateLeague(seasonID uuid.UUID, teamIDs []uuid.UUID, startDate time.Time
 
 	return matches
 }
-
-func generateCup(seasonID uuid.UUID, teamIDs []uuid.UUID, startDate time.Time) []domain.SeasonMatch {
-	rand.Shuffle(len(teamIDs), func(i, j int) {
-		teamIDs[i], teamIDs[j] = teamIDs[j], teamIDs[i]
-	})
-
-	var matches []domain.SeasonMatch
-	for i := 0; i+1 < len(teamIDs); i += 2 {
-		matchDate := startDate.AddDate(0, 0, (i/2)*7)
-		match := domain.SeasonMatch{
-			SeasonID:   seasonID,
-			HomeTeamID: teamIDs[i],
-			AwayTeamID: teamIDs[i+1],
-			MatchDate:  matchDate,
-		}
-		matches = append(matches, match)
-	}
-
-	return matches
-}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
 type ClassificationInfo struct {
@@ -19,9 +20,26 @@ type TeamClassificationInfo struct {
 	TeamName       string    `json:"team_name"`
 	Position       int       `json:"position"`
 	Points         int       `json:"points"`
+	Played         int       `json:"played"`
+	Won            int       `json:"won"`
+	Drawn          int       `json:"drawn"`
+	Lost           int       `json:"lost"`
 	GoalsFor       int       `json:"goals_for"`
 	GoalsAgainst   int       `json:"goals_against"`
 	GoalDifference int       `json:"goal_difference"`
+	Form           string    `json:"form"`
+	Home           SplitInfo `json:"home"`
+	Away           SplitInfo `json:"away"`
+}
+
+type SplitInfo struct {
+	Played       int `json:"played"`
+	Won          int `json:"won"`
+	Drawn        int `json:"drawn"`
+	Lost         int `json:"lost"`
+	GoalsFor     int `json:"goals_for"`
+	GoalsAgainst int `json:"goals_against"`
+	Points       int `json:"points"`
 }
 
 func (h *Handler) GetClassification(c *gin.Context) {
@@ -50,9 +68,16 @@ func (h *Handler) GetClassification(c *gin.Context) {
 				TeamName:       team.TeamName,
 				Position:       team.Position,
 				Points:         team.Points,
+				Played:         team.Played,
+				Won:            team.Won,
+				Drawn:          team.Drawn,
+				Lost:           team.Lost,
 				GoalsFor:       team.GoalsFor,
 				GoalsAgainst:   team.GoalsAgainst,
 				GoalDifference: team.GoalDifference,
+				Form:           team.Form,
+				Home:           newSplitInfo(team.Home),
+				Away:           newSplitInfo(team.Away),
 			})
 		}
 
@@ -65,3 +90,15 @@ func (h *Handler) GetClassification(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+func newSplitInfo(split domain.ClassificationSplit) SplitInfo {
+	return SplitInfo{
+		Played:       split.Played,
+		Won:          split.Won,
+		Drawn:        split.Drawn,
+		Lost:         split.Lost,
+		GoalsFor:     split.GoalsFor,
+		GoalsAgainst: split.GoalsAgainst,
+		Points:       split.Points,
+	}
+}
@@ -0,0 +1,83 @@
+package cup
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CupTieInfo struct {
+	TieID        uuid.UUID      `json:"tie_id"`
+	Round        int            `json:"round"`
+	Position     int            `json:"position"`
+	HomeTeamID   uuid.UUID      `json:"home_team_id"`
+	AwayTeamID   *uuid.UUID     `json:"away_team_id,omitempty"`
+	WinnerTeamID *uuid.UUID     `json:"winner_team_id,omitempty"`
+	TwoLegged    bool           `json:"two_legged"`
+	Bye          bool           `json:"bye"`
+	Legs         []CupMatchInfo `json:"legs,omitempty"`
+}
+
+type CupMatchInfo struct {
+	MatchID       uuid.UUID `json:"match_id"`
+	Leg           int       `json:"leg"`
+	MatchDate     time.Time `json:"match_date"`
+	HomeTeamID    uuid.UUID `json:"home_team_id"`
+	AwayTeamID    uuid.UUID `json:"away_team_id"`
+	HomeResult    *int      `json:"home_result,omitempty"`
+	AwayResult    *int      `json:"away_result,omitempty"`
+	ExtraTime     bool      `json:"extra_time"`
+	HomePenalties *int      `json:"home_penalties,omitempty"`
+	AwayPenalties *int      `json:"away_penalties,omitempty"`
+}
+
+func (h Handler) GetCupTies(c *gin.Context) {
+	seasonIDParam := c.Param("season_id")
+	seasonID, err := uuid.Parse(seasonIDParam)
+	if err != nil {
+		log.Printf("Invalid season_id: %s | Error: %v", seasonIDParam, err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid season_id"})
+		return
+	}
+
+	ties, err := h.app.GetCupTies(seasonID)
+	if err != nil {
+		log.Printf("Failed to get cup ties for season_id %s | Error: %v", seasonID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get cup ties"})
+		return
+	}
+
+	response := make([]CupTieInfo, 0, len(ties))
+	for _, tie := range ties {
+		info := CupTieInfo{
+			TieID:        tie.ID,
+			Round:        tie.Round,
+			Position:     tie.Position,
+			HomeTeamID:   tie.HomeTeamID,
+			AwayTeamID:   tie.AwayTeamID,
+			WinnerTeamID: tie.WinnerTeamID,
+			TwoLegged:    tie.TwoLegged,
+			Bye:          tie.AwayTeamID == nil,
+		}
+		for _, m := range tie.Matches {
+			info.Legs = append(info.Legs, CupMatchInfo{
+				MatchID:       m.ID,
+				Leg:           m.Leg,
+				MatchDate:     m.MatchDate,
+				HomeTeamID:    m.HomeTeamID,
+				AwayTeamID:    m.AwayTeamID,
+				HomeResult:    m.HomeResult,
+				AwayResult:    m.AwayResult,
+				ExtraTime:     m.ExtraTime,
+				HomePenalties: m.HomePenalties,
+				AwayPenalties: m.AwayPenalties,
+			})
+		}
+		response = append(response, info)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
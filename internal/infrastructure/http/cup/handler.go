@@ -0,0 +1,20 @@
+package cup
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type App interface {
+	GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error)
+}
+
+func NewHandler(app App) Handler {
+	return Handler{
+		app: app,
+	}
+}
+
+type Handler struct {
+	app App
+}
@@ -0,0 +1,22 @@
+package lineup
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h Handler) GetLineup(c *gin.Context) {
+	teamID, ok := parseTeamID(c)
+	if !ok {
+		return
+	}
+
+	lineup, err := h.app.GetLineup(teamID)
+	if err != nil {
+		writeLineupError(c, "GetLineup", teamID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newLineupResponse(lineup))
+}
@@ -0,0 +1,22 @@
+package lineup
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type App interface {
+	GetLineup(teamID uuid.UUID) (domain.Lineup, error)
+	SetLineup(teamID uuid.UUID, starterIDs, benchIDs []uuid.UUID) (domain.Lineup, error)
+	CheckLineup(teamID uuid.UUID, starterIDs, benchIDs []uuid.UUID) (domain.Lineup, error)
+}
+
+func NewHandler(app App) Handler {
+	return Handler{
+		app: app,
+	}
+}
+
+type Handler struct {
+	app App
+}
@@ -0,0 +1,90 @@
+package lineup
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type LineupRequest struct {
+	Starters []uuid.UUID `json:"starters"`
+	Bench    []uuid.UUID `json:"bench"`
+}
+
+type LineupResponse struct {
+	TeamID    uuid.UUID    `json:"team_id"`
+	Formation string       `json:"formation"`
+	Starters  []PlayerInfo `json:"starters"`
+	Bench     []PlayerInfo `json:"bench"`
+}
+
+type PlayerInfo struct {
+	PlayerID         uuid.UUID `json:"player_id"`
+	FirstName        string    `json:"first_name"`
+	LastName         string    `json:"last_name"`
+	Position         string    `json:"position"`
+	Technique        int       `json:"technique"`
+	Mental           int       `json:"mental"`
+	Physique         int       `json:"physique"`
+	InjuryDays       int       `json:"injury_days"`
+	YellowCards      int       `json:"yellow_cards"`
+	SuspendedMatches int       `json:"suspended_matches"`
+}
+
+func newLineupResponse(lineup domain.Lineup) LineupResponse {
+	response := LineupResponse{
+		TeamID:    lineup.TeamID,
+		Formation: lineup.Formation,
+		Starters:  []PlayerInfo{},
+		Bench:     []PlayerInfo{},
+	}
+	for _, p := range lineup.Starters {
+		response.Starters = append(response.Starters, newPlayerInfo(p))
+	}
+	for _, p := range lineup.Bench {
+		response.Bench = append(response.Bench, newPlayerInfo(p))
+	}
+	return response
+}
+
+func newPlayerInfo(p domain.Player) PlayerInfo {
+	return PlayerInfo{
+		PlayerID:         p.PlayerId,
+		FirstName:        p.FirstName,
+		LastName:         p.LastName,
+		Position:         p.Position,
+		Technique:        p.Technique,
+		Mental:           p.Mental,
+		Physique:         p.Physique,
+		InjuryDays:       p.InjuryDays,
+		YellowCards:      p.YellowCards,
+		SuspendedMatches: p.SuspendedMatches,
+	}
+}
+
+func parseTeamID(c *gin.Context) (uuid.UUID, bool) {
+	teamIDParam := c.Param("team_id")
+	teamID, err := uuid.Parse(teamIDParam)
+	if err != nil {
+		log.Printf("Invalid team_id: %s | Error: %v", teamIDParam, err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid team_id"})
+		return uuid.Nil, false
+	}
+	return teamID, true
+}
+
+func writeLineupError(c *gin.Context, action string, teamID uuid.UUID, err error) {
+	switch {
+	case errors.Is(err, domain.ErrTeamNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domain.ErrInvalidLineup):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		log.Printf("[%s] error for team %s: %v", action, teamID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
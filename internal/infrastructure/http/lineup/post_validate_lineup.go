@@ -0,0 +1,30 @@
+package lineup
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h Handler) PostValidateLineup(c *gin.Context) {
+	teamID, ok := parseTeamID(c)
+	if !ok {
+		return
+	}
+
+	var req LineupRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Printf("[PostValidateLineup] error parsing request: %v", err)
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	lineup, err := h.app.CheckLineup(teamID, req.Starters, req.Bench)
+	if err != nil {
+		writeLineupError(c, "PostValidateLineup", teamID, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, newLineupResponse(lineup))
+}
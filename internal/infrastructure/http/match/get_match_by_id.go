@@ -15,19 +15,61 @@ type TeamInfo struct {
 }
 
 type MatchEvent struct {
-	ID        uuid.UUID `json:"id"`
-	EventType string    `json:"event_type"`
-	Minute    int       `json:"minute"`
+	ID                uuid.UUID  `json:"id"`
+	TeamID            uuid.UUID  `json:"team_id"`
+	EventType         string     `json:"event_type"`
+	Minute            int        `json:"minute"`
+	AddedTime         int        `json:"added_time,omitempty"`
+	MinuteLabel       string     `json:"minute_label"`
+	Description       string     `json:"description"`
+	PlayerID          *uuid.UUID `json:"player_id,omitempty"`
+	SecondaryPlayerID *uuid.UUID `json:"secondary_player_id,omitempty"`
+	OpponentPlayerID  *uuid.UUID `json:"opponent_player_id,omitempty"`
+	Outcome           string     `json:"outcome,omitempty"`
+	XG                float64    `json:"xg,omitempty"`
+}
+
+type TeamStats struct {
+	BallPossession  int `json:"ball_possession"`
+	ScoringChances  int `json:"scoring_chances"`
+	Shots           int `json:"shots"`
+	ShotsOnTarget   int `json:"shots_on_target"`
+	Corners         int `json:"corners"`
+	Fouls           int `json:"fouls"`
+	YellowCards     int `json:"yellow_cards"`
+	RedCards        int `json:"red_cards"`
+	Offsides        int `json:"offsides"`
+	Saves           int `json:"saves"`
+	Passes          int `json:"passes"`
+	PassesCompleted int `json:"passes_completed"`
+	PassSuccess     int `json:"pass_success"`
+}
+
+type PlayerRating struct {
+	PlayerID      uuid.UUID `json:"player_id"`
+	TeamID        uuid.UUID `json:"team_id"`
+	Minutes       int       `json:"minutes"`
+	Rating        float64   `json:"rating"`
+	ManOfTheMatch bool      `json:"man_of_the_match,omitempty"`
 }
 
 type MatchResponse struct {
-	MatchID    uuid.UUID    `json:"match_id"`
-	MatchDate  time.Time    `json:"match_date"`
-	HomeTeam   TeamInfo     `json:"home_team"`
-	AwayTeam   TeamInfo     `json:"away_team"`
-	HomeResult *int         `json:"home_result,omitempty"`
-	AwayResult *int         `json:"away_result,omitempty"`
-	Events     []MatchEvent `json:"events,omitempty"`
+	MatchID       uuid.UUID      `json:"match_id"`
+	MatchDate     time.Time      `json:"match_date"`
+	HomeTeam      TeamInfo       `json:"home_team"`
+	AwayTeam      TeamInfo       `json:"away_team"`
+	HomeResult    *int           `json:"home_result,omitempty"`
+	AwayResult    *int           `json:"away_result,omitempty"`
+	HomeHalfTime  *int           `json:"home_half_time_result,omitempty"`
+	AwayHalfTime  *int           `json:"away_half_time_result,omitempty"`
+	HomeXG        *float64       `json:"home_xg,omitempty"`
+	AwayXG        *float64       `json:"away_xg,omitempty"`
+	HomeStats     *TeamStats     `json:"home_stats,omitempty"`
+	AwayStats     *TeamStats     `json:"away_stats,omitempty"`
+	ManOfTheMatch *PlayerRating  `json:"man_of_the_match,omitempty"`
+	Ratings       []PlayerRating `json:"ratings,omitempty"`
+	Seed          *int64         `json:"seed,omitempty"`
+	Events        []MatchEvent   `json:"events,omitempty"`
 }
 
 func (h *Handler) GetMatchByID(c *gin.Context) {
@@ -0,0 +1,56 @@
+package match
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SeasonRating struct {
+	PlayerID      uuid.UUID `json:"player_id"`
+	TeamID        uuid.UUID `json:"team_id"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Matches       int       `json:"matches"`
+	Minutes       int       `json:"minutes"`
+	Rating        float64   `json:"average_rating"`
+	ManOfTheMatch int       `json:"man_of_the_match"`
+}
+
+func (h *Handler) GetSeasonRatings(c *gin.Context) {
+	seasonIDParam := c.Param("season_id")
+	seasonID, err := uuid.Parse(seasonIDParam)
+	if err != nil {
+		log.Printf("Invalid season_id: %s | Error: %v", seasonIDParam, err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid season_id"})
+		return
+	}
+
+	ratings, err := h.matchApp.GetSeasonRatings(seasonID)
+	if err != nil {
+		log.Printf("Failed to get player ratings for season_id %s | Error: %v", seasonID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get player ratings"})
+		return
+	}
+
+	response := make([]SeasonRating, 0, len(ratings))
+	for _, rating := range ratings {
+		response = append(response, SeasonRating{
+			PlayerID:      rating.PlayerID,
+			TeamID:        rating.TeamID,
+			FirstName:     rating.FirstName,
+			LastName:      rating.LastName,
+			Matches:       rating.Matches,
+			Minutes:       rating.Minutes,
+			Rating:        rating.Rating,
+			ManOfTheMatch: rating.ManOfTheMatch,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"season_id": seasonID,
+		"ratings":   response,
+	})
+}
@@ -8,10 +8,11 @@ import (
 )
 
 type MatchApp interface {
-	PlayMatch(seasonID, matchID uuid.UUID) (domain.Result, error)
+	PlayMatch(seasonID, matchID uuid.UUID, seed *int64) (domain.Result, error)
 	GetPendingMatches(timestamp time.Time) ([]domain.SeasonMatch, error)
 	GetMatchDetailsByID(matchID uuid.UUID) (*MatchResponse, error)
 	GetSeasonMatches(seasonID uuid.UUID) ([]domain.SeasonMatch, error)
+	GetSeasonRatings(seasonID uuid.UUID) ([]domain.SeasonRating, error)
 }
 
 type TeamApp interface {
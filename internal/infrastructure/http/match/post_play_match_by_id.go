@@ -1,17 +1,20 @@
 package match
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	nethttp "net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
 type MatchRequest struct {
 	SeasonId uuid.UUID `json:"season_id"`
 	MatchId  uuid.UUID `json:"match_id"`
+	Seed     *int64    `json:"seed,omitempty"`
 }
 
 func (h Handler) PostPlayMatchbyId(c *gin.Context) {
@@ -24,7 +27,16 @@ func (h Handler) PostPlayMatchbyId(c *gin.Context) {
 
 	log.Printf("match id: %s", req.MatchId)
 
-	result, err := h.matchApp.PlayMatch(req.SeasonId, req.MatchId)
+	result, err := h.matchApp.PlayMatch(req.SeasonId, req.MatchId, req.Seed)
+	if errors.Is(err, domain.ErrMatchAlreadyPlayed) {
+		log.Printf("[PostPlayMatchbyId] match %s already played", req.MatchId)
+		c.JSON(nethttp.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, domain.ErrLineupNotSet) {
+		c.JSON(nethttp.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		log.Printf("[PostPlayMatchbyId] error playing match %s: %v", req.MatchId, err)
 		c.JSON(nethttp.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -0,0 +1,60 @@
+package player
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type InjuryResponse struct {
+	PlayerID   uuid.UUID `json:"player_id"`
+	FirstName  string    `json:"first_name"`
+	LastName   string    `json:"last_name"`
+	Position   string    `json:"position"`
+	Injury     string    `json:"injury"`
+	InjuryDays int       `json:"injury_days"`
+	MatchesOut int       `json:"matches_out"`
+}
+
+func (h Handler) GetTeamInjuries(c *gin.Context) {
+	teamIDParam := c.Param("team_id")
+	teamID, err := uuid.Parse(teamIDParam)
+	if err != nil {
+		log.Printf("[GetTeamInjuries] invalid team_id: %s | Error: %v", teamIDParam, err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid team_id"})
+		return
+	}
+
+	injured, err := h.app.GetTeamInjuries(teamID)
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		log.Printf("[GetTeamInjuries] error retrieving injuries for team %s: %v", teamID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]InjuryResponse, 0, len(injured))
+	for _, p := range injured {
+		response = append(response, InjuryResponse{
+			PlayerID:   p.PlayerId,
+			FirstName:  p.FirstName,
+			LastName:   p.LastName,
+			Position:   p.Position,
+			Injury:     string(p.Injury),
+			InjuryDays: p.InjuryDays,
+			MatchesOut: (p.InjuryDays + domain.RecoveryDaysPerMatchday - 1) / domain.RecoveryDaysPerMatchday,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team_id":  teamID,
+		"injuries": response,
+	})
+}
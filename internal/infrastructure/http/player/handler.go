@@ -1,11 +1,14 @@
 package player
 
 import (
+	"github.com/google/uuid"
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
 type App interface {
 	GeneratePlayer(country, position string) (domain.Player, error)
+	GenerateSquad(teamID uuid.UUID, nationalities []domain.NationalityShare, quality domain.QualityBand) ([]domain.Player, error)
+	GetTeamInjuries(teamID uuid.UUID) ([]domain.Player, error)
 }
 
 func NewHandler(app App) Handler {
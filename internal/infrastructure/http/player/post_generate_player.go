@@ -1,11 +1,13 @@
 package player
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	nethttp "net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
 type PostGeneratePlayerRequest struct {
@@ -22,6 +24,10 @@ func (h Handler) PostGeneratePlayer(c *gin.Context) {
 	}
 
 	player, err := h.app.GeneratePlayer(req.Country, req.Position)
+	if errors.Is(err, domain.ErrUnknownCountry) {
+		c.JSON(nethttp.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		log.Printf("[PostGeneratePlayer] error generating player (country=%s, position=%s): %v", req.Country, req.Position, err)
 		c.JSON(nethttp.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -0,0 +1,63 @@
+package player
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type PostGenerateSquadRequest struct {
+	TeamID        uuid.UUID          `json:"team_id"`
+	Nationalities []NationalityShare `json:"nationalities"`
+	MinQuality    int                `json:"min_quality"`
+	MaxQuality    int                `json:"max_quality"`
+}
+
+type NationalityShare struct {
+	Country string `json:"country"`
+	Weight  int    `json:"weight"`
+}
+
+func (h Handler) PostGenerateSquad(c *gin.Context) {
+	var req PostGenerateSquadRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.Printf("[PostGenerateSquad] error parsing request: %v", err)
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	nationalities := make([]domain.NationalityShare, 0, len(req.Nationalities))
+	for _, n := range req.Nationalities {
+		nationalities = append(nationalities, domain.NationalityShare{
+			Country: n.Country,
+			Weight:  n.Weight,
+		})
+	}
+
+	players, err := h.app.GenerateSquad(req.TeamID, nationalities, domain.QualityBand{
+		Min: req.MinQuality,
+		Max: req.MaxQuality,
+	})
+	if errors.Is(err, domain.ErrTeamNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidQualityBand) || errors.Is(err, domain.ErrInvalidNationalities) || errors.Is(err, domain.ErrUnknownCountry) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		log.Printf("[PostGenerateSquad] error generating squad for team %s: %v", req.TeamID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team_id": req.TeamID,
+		"players": players,
+	})
+}
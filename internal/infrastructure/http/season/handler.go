@@ -0,0 +1,20 @@
+package season
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type App interface {
+	EndSeason(seasonID uuid.UUID) (domain.SeasonTransition, error)
+}
+
+func NewHandler(app App) Handler {
+	return Handler{
+		app: app,
+	}
+}
+
+type Handler struct {
+	app App
+}
@@ -0,0 +1,80 @@
+package season
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+type SeasonTransitionResponse struct {
+	ClosedSeasons   []uuid.UUID    `json:"closed_seasons"`
+	NextSeasons     []SeasonInfo   `json:"next_seasons"`
+	Moves           []TeamMoveInfo `json:"moves"`
+	PlayoffsPending bool           `json:"playoffs_pending"`
+}
+
+type SeasonInfo struct {
+	SeasonID     uuid.UUID `json:"season_id"`
+	TournamentID uuid.UUID `json:"tournament_id"`
+	FromDate     time.Time `json:"from_date"`
+	ToDate       time.Time `json:"to_date"`
+}
+
+type TeamMoveInfo struct {
+	TeamID           uuid.UUID `json:"team_id"`
+	FromTournamentID uuid.UUID `json:"from_tournament_id"`
+	ToTournamentID   uuid.UUID `json:"to_tournament_id"`
+}
+
+func (h Handler) PostEndSeason(c *gin.Context) {
+	seasonIDParam := c.Param("season_id")
+	seasonID, err := uuid.Parse(seasonIDParam)
+	if err != nil {
+		log.Printf("Invalid season_id: %s | Error: %v", seasonIDParam, err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid season_id"})
+		return
+	}
+
+	transition, err := h.app.EndSeason(seasonID)
+	if errors.Is(err, domain.ErrSeasonNotFinished) || errors.Is(err, domain.ErrSeasonClosed) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		log.Printf("[PostEndSeason] error ending season %s: %v", seasonID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := SeasonTransitionResponse{
+		ClosedSeasons:   transition.ClosedSeasons,
+		PlayoffsPending: transition.PlayoffsPending,
+	}
+	for _, s := range transition.NextSeasons {
+		response.NextSeasons = append(response.NextSeasons, SeasonInfo{
+			SeasonID:     s.ID,
+			TournamentID: s.TournamentID,
+			FromDate:     s.FromDate,
+			ToDate:       s.ToDate,
+		})
+	}
+	for _, m := range transition.Moves {
+		response.Moves = append(response.Moves, TeamMoveInfo{
+			TeamID:           m.TeamID,
+			FromTournamentID: m.FromTournamentID,
+			ToTournamentID:   m.ToTournamentID,
+		})
+	}
+
+	if transition.PlayoffsPending {
+		c.JSON(http.StatusAccepted, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
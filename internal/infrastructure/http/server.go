@@ -9,8 +9,11 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/classification"
 	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/country"
+	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/cup"
+	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/lineup"
 	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/match"
 	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/player"
+	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/season"
 	"github.com/robertobouses/online-football-tycoon/internal/infrastructure/http/tournament"
 )
 
@@ -20,6 +23,9 @@ type Server struct {
 	classification classification.Handler
 	country        country.Handler
 	tournament     tournament.Handler
+	cup            cup.Handler
+	season         season.Handler
+	lineup         lineup.Handler
 	engine         *gin.Engine
 }
 
@@ -29,6 +35,9 @@ func NewServer(
 	classification classification.Handler,
 	country country.Handler,
 	tournament tournament.Handler,
+	cup cup.Handler,
+	season season.Handler,
+	lineup lineup.Handler,
 
 ) Server {
 
@@ -38,6 +47,9 @@ func NewServer(
 		classification: classification,
 		country:        country,
 		tournament:     tournament,
+		cup:            cup,
+		season:         season,
+		lineup:         lineup,
 		engine:         gin.Default(),
 	}
 }
@@ -61,13 +73,23 @@ func (s *Server) Run(port string) error {
 
 	player := s.engine.Group("/player")
 	player.POST("/generate", s.player.PostGeneratePlayer)
+	player.POST("/squad", s.player.PostGenerateSquad)
 
 	classification := s.engine.Group("/season")
 	classification.GET("/:season_id/classification", s.classification.GetClassification)
+	classification.GET("/:season_id/cup", s.cup.GetCupTies)
+	classification.GET("/:season_id/ratings", s.match.GetSeasonRatings)
+	classification.POST("/:season_id/end", s.season.PostEndSeason)
 
 	country := s.engine.Group("/country")
 	country.GET("/", s.country.GetCountries)
 
+	team := s.engine.Group("/team")
+	team.GET("/:team_id/lineup", s.lineup.GetLineup)
+	team.PUT("/:team_id/lineup", s.lineup.PutLineup)
+	team.POST("/:team_id/lineup/validate", s.lineup.PostValidateLineup)
+	team.GET("/:team_id/injuries", s.player.GetTeamInjuries)
+
 	tournament := s.engine.Group("/tournament")
 	tournament.GET("/:country", s.tournament.GetTournamentsByCountry)
 
@@ -24,6 +24,25 @@ func (r *Repository) GetClassification(seasonID uuid.UUID) ([]domain.Classificat
 			&classified.GoalsFor,
 			&classified.GoalsAgainst,
 			&classified.GoalDifference,
+			&classified.Played,
+			&classified.Won,
+			&classified.Drawn,
+			&classified.Lost,
+			&classified.Form,
+			&classified.Home.Played,
+			&classified.Home.Won,
+			&classified.Home.Drawn,
+			&classified.Home.Lost,
+			&classified.Home.GoalsFor,
+			&classified.Home.GoalsAgainst,
+			&classified.Home.Points,
+			&classified.Away.Played,
+			&classified.Away.Won,
+			&classified.Away.Drawn,
+			&classified.Away.Lost,
+			&classified.Away.GoalsFor,
+			&classified.Away.GoalsAgainst,
+			&classified.Away.Points,
 		)
 		if err != nil {
 			return nil, err
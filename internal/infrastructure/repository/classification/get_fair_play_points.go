@@ -0,0 +1,29 @@
+package classification
+
+import (
+	"github.com/google/uuid"
+)
+
+func (r *Repository) GetFairPlayPoints(seasonID uuid.UUID) (map[uuid.UUID]int, error) {
+	rows, err := r.getFairPlayPoints.Query(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fairPlayPoints := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var teamID uuid.UUID
+		var points int
+		if err := rows.Scan(&teamID, &points); err != nil {
+			return nil, err
+		}
+		fairPlayPoints[teamID] = points
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return fairPlayPoints, nil
+}
@@ -9,14 +9,8 @@ import (
 //go:embed sql/get_classification.sql
 var getClassificationQuery string
 
-//go:embed sql/get_team_classification.sql
-var getTeamClassificationQuery string
-
-//go:embed sql/insert_classification.sql
-var insertClassificationQuery string
-
-//go:embed sql/update_classification.sql
-var updateClassificationQuery string
+//go:embed sql/get_fair_play_points.sql
+var getFairPlayPointsQuery string
 
 func NewRepository(db *sql.DB) (*Repository, error) {
 
@@ -25,34 +19,20 @@ func NewRepository(db *sql.DB) (*Repository, error) {
 		return nil, err
 	}
 
-	getTeamClassificationStmt, err := db.Prepare(getTeamClassificationQuery)
-	if err != nil {
-		return nil, err
-	}
-
-	insertClassificationStmt, err := db.Prepare(insertClassificationQuery)
-	if err != nil {
-		return nil, err
-	}
-
-	updateClassificationStmt, err := db.Prepare(updateClassificationQuery)
+	getFairPlayPointsStmt, err := db.Prepare(getFairPlayPointsQuery)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Repository{
-		db:                    db,
-		getClassification:     getClassificationStmt,
-		getTeamClassification: getTeamClassificationStmt,
-		insertClassification:  insertClassificationStmt,
-		updateClassification:  updateClassificationStmt,
+		db:                db,
+		getClassification: getClassificationStmt,
+		getFairPlayPoints: getFairPlayPointsStmt,
 	}, nil
 }
 
 type Repository struct {
-	db                    *sql.DB
-	getClassification     *sql.Stmt
-	getTeamClassification *sql.Stmt
-	insertClassification  *sql.Stmt
-	updateClassification  *sql.Stmt
+	db                *sql.DB
+	getClassification *sql.Stmt
+	getFairPlayPoints *sql.Stmt
 }
@@ -1,13 +1,32 @@
 SELECT 
 te.id,
 te.name,
-RANK() OVER (ORDER BY cl.points DESC, (cl.goals_for - cl.goals_against) DESC) AS position,
-cl.points,
-cl.goals_for,
-cl.goals_against,
-(cl.goals_for-cl.goals_against) AS goal_difference
+RANK() OVER (ORDER BY COALESCE(cl.points, 0) DESC, (COALESCE(cl.goals_for, 0) - COALESCE(cl.goals_against, 0)) DESC) AS position,
+COALESCE(cl.points, 0),
+COALESCE(cl.goals_for, 0),
+COALESCE(cl.goals_against, 0),
+(COALESCE(cl.goals_for, 0) - COALESCE(cl.goals_against, 0)) AS goal_difference,
+COALESCE(cl.played, 0),
+COALESCE(cl.won, 0),
+COALESCE(cl.drawn, 0),
+COALESCE(cl.lost, 0),
+COALESCE(cl.form, ''),
+COALESCE(cl.home_played, 0),
+COALESCE(cl.home_won, 0),
+COALESCE(cl.home_drawn, 0),
+COALESCE(cl.home_lost, 0),
+COALESCE(cl.home_goals_for, 0),
+COALESCE(cl.home_goals_against, 0),
+COALESCE(cl.home_points, 0),
+COALESCE(cl.away_played, 0),
+COALESCE(cl.away_won, 0),
+COALESCE(cl.away_drawn, 0),
+COALESCE(cl.away_lost, 0),
+COALESCE(cl.away_goals_for, 0),
+COALESCE(cl.away_goals_against, 0),
+COALESCE(cl.away_points, 0)
 FROM oft.season_team st
 JOIN oft.team te ON st.team_id = te.id
-LEFT JOIN oft.classification cl ON cl.team_id = te.id
+LEFT JOIN oft.classification cl ON cl.team_id = te.id AND cl.season_id = st.season_id
 WHERE st.season_id = $1
-ORDER BY position;
\ No newline at end of file
+ORDER BY position;
@@ -0,0 +1,9 @@
+SELECT
+me.team_id,
+SUM(CASE WHEN me.event_type = 'RED_CARD' THEN 3 ELSE 1 END) AS fair_play_points
+FROM oft.match_events me
+JOIN oft.match m ON me.match_id = m.id
+WHERE m.season_id = $1
+AND m.cup_tie_id IS NULL
+AND me.event_type IN ('YELLOW_CARD', 'RED_CARD')
+GROUP BY me.team_id;
@@ -1,2 +0,0 @@
-INSERT INTO oft.classification (team_id, points, goals_for, goals_against)
-VALUES ($1, $2, $3, $4)
@@ -0,0 +1,30 @@
+package cup
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetCupTie(tieID uuid.UUID) (domain.CupTie, error) {
+	var tie domain.CupTie
+	if err := r.getCupTie.QueryRow(tieID).Scan(
+		&tie.ID,
+		&tie.SeasonID,
+		&tie.Round,
+		&tie.Position,
+		&tie.HomeTeamID,
+		&tie.AwayTeamID,
+		&tie.WinnerTeamID,
+		&tie.TwoLegged,
+	); err != nil {
+		return domain.CupTie{}, err
+	}
+
+	matches, err := r.getSeasonCupMatches(tie.SeasonID)
+	if err != nil {
+		return domain.CupTie{}, err
+	}
+	tie.Matches = matches[tie.ID]
+
+	return tie, nil
+}
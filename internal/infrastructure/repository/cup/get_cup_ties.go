@@ -0,0 +1,80 @@
+package cup
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetCupTies(seasonID uuid.UUID) ([]domain.CupTie, error) {
+	rows, err := r.getCupTies.Query(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ties []domain.CupTie
+	for rows.Next() {
+		var tie domain.CupTie
+		if err := rows.Scan(
+			&tie.ID,
+			&tie.SeasonID,
+			&tie.Round,
+			&tie.Position,
+			&tie.HomeTeamID,
+			&tie.AwayTeamID,
+			&tie.WinnerTeamID,
+			&tie.TwoLegged,
+		); err != nil {
+			return nil, err
+		}
+		ties = append(ties, tie)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	matches, err := r.getSeasonCupMatches(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ties {
+		ties[i].Matches = matches[ties[i].ID]
+	}
+
+	return ties, nil
+}
+
+func (r *Repository) getSeasonCupMatches(seasonID uuid.UUID) (map[uuid.UUID][]domain.SeasonMatch, error) {
+	rows, err := r.getCupMatches.Query(seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matches := make(map[uuid.UUID][]domain.SeasonMatch)
+	for rows.Next() {
+		var m domain.SeasonMatch
+		if err := rows.Scan(
+			&m.ID,
+			&m.SeasonID,
+			&m.HomeTeamID,
+			&m.AwayTeamID,
+			&m.MatchDate,
+			&m.HomeResult,
+			&m.AwayResult,
+			&m.CupTieID,
+			&m.Leg,
+			&m.ExtraTime,
+			&m.HomePenalties,
+			&m.AwayPenalties,
+		); err != nil {
+			return nil, err
+		}
+		matches[*m.CupTieID] = append(matches[*m.CupTieID], m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,21 @@
+package cup
+
+import "github.com/google/uuid"
+
+func (r *Repository) GetOpenCupSeasons() ([]uuid.UUID, error) {
+	rows, err := r.getOpenCupSeasons.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seasonIDs []uuid.UUID
+	for rows.Next() {
+		var seasonID uuid.UUID
+		if err := rows.Scan(&seasonID); err != nil {
+			return nil, err
+		}
+		seasonIDs = append(seasonIDs, seasonID)
+	}
+	return seasonIDs, rows.Err()
+}
@@ -0,0 +1,58 @@
+package cup
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) PostCupRound(ties []domain.CupTie) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	postCupTie := tx.Stmt(r.postCupTie)
+	postCupMatch := tx.Stmt(r.postCupMatch)
+
+	for _, tie := range ties {
+		var tieID uuid.UUID
+		err := postCupTie.QueryRow(
+			tie.SeasonID,
+			tie.Round,
+			tie.Position,
+			tie.HomeTeamID,
+			tie.AwayTeamID,
+			tie.WinnerTeamID,
+			tie.TwoLegged,
+		).Scan(&tieID)
+		if err == sql.ErrNoRows {
+			log.Printf("Cup tie round %d position %d already exists for season %s", tie.Round, tie.Position, tie.SeasonID)
+			continue
+		}
+		if err != nil {
+			log.Printf("Error inserting cup tie: %v", err)
+			return err
+		}
+
+		for _, m := range tie.Matches {
+			if _, err := postCupMatch.Exec(
+				m.SeasonID,
+				m.HomeTeamID,
+				m.AwayTeamID,
+				m.MatchDate,
+				tieID,
+				m.Leg,
+			); err != nil {
+				log.Printf("Error inserting cup match: %v", err)
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
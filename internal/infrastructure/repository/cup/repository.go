@@ -0,0 +1,77 @@
+package cup
+
+import (
+	"database/sql"
+
+	_ "embed"
+)
+
+//go:embed sql/get_cup_ties.sql
+var getCupTiesQuery string
+
+//go:embed sql/get_cup_tie.sql
+var getCupTieQuery string
+
+//go:embed sql/get_cup_matches.sql
+var getCupMatchesQuery string
+
+//go:embed sql/post_cup_tie.sql
+var postCupTieQuery string
+
+//go:embed sql/post_cup_match.sql
+var postCupMatchQuery string
+
+//go:embed sql/get_open_cup_seasons.sql
+var getOpenCupSeasonsQuery string
+
+func NewRepository(db *sql.DB) (*Repository, error) {
+	getCupTiesStmt, err := db.Prepare(getCupTiesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getCupTieStmt, err := db.Prepare(getCupTieQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getCupMatchesStmt, err := db.Prepare(getCupMatchesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	postCupTieStmt, err := db.Prepare(postCupTieQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	postCupMatchStmt, err := db.Prepare(postCupMatchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getOpenCupSeasonsStmt, err := db.Prepare(getOpenCupSeasonsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:                db,
+		getCupTies:        getCupTiesStmt,
+		getCupTie:         getCupTieStmt,
+		getCupMatches:     getCupMatchesStmt,
+		postCupTie:        postCupTieStmt,
+		postCupMatch:      postCupMatchStmt,
+		getOpenCupSeasons: getOpenCupSeasonsStmt,
+	}, nil
+}
+
+type Repository struct {
+	db                *sql.DB
+	getCupTies        *sql.Stmt
+	getCupTie         *sql.Stmt
+	getCupMatches     *sql.Stmt
+	postCupTie        *sql.Stmt
+	postCupMatch      *sql.Stmt
+	getOpenCupSeasons *sql.Stmt
+}
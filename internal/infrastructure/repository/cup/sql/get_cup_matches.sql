@@ -0,0 +1,17 @@
+SELECT
+    m.id,
+    m.season_id,
+    m.home_team,
+    m.away_team,
+    m.match_date,
+    m.home_result,
+    m.away_result,
+    m.cup_tie_id,
+    COALESCE(m.leg, 0),
+    m.extra_time,
+    m.home_penalties,
+    m.away_penalties
+FROM oft.match m
+JOIN oft.cup_tie ct ON m.cup_tie_id = ct.id
+WHERE ct.season_id = $1
+ORDER BY m.leg;
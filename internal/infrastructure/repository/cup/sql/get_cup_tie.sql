@@ -0,0 +1,11 @@
+SELECT
+    id,
+    season_id,
+    round,
+    position,
+    home_team,
+    away_team,
+    winner_team,
+    two_legged
+FROM oft.cup_tie
+WHERE id = $1;
@@ -0,0 +1,4 @@
+SELECT DISTINCT ct.season_id
+FROM oft.cup_tie ct
+JOIN oft.season s ON s.id = ct.season_id
+WHERE s.closed = FALSE;
@@ -0,0 +1,10 @@
+INSERT INTO oft.match (
+    season_id,
+    home_team,
+    away_team,
+    match_date,
+    cup_tie_id,
+    leg
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
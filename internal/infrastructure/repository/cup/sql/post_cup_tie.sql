@@ -0,0 +1,13 @@
+INSERT INTO oft.cup_tie (
+    season_id,
+    round,
+    position,
+    home_team,
+    away_team,
+    winner_team,
+    two_legged
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (season_id, round, position) DO NOTHING
+RETURNING id;
@@ -0,0 +1,46 @@
+package lineup
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetSquad(teamID uuid.UUID) ([]domain.Player, error) {
+	rows, err := r.getSquad.Query(teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var squad []domain.Player
+	for rows.Next() {
+		var p domain.Player
+		if err := rows.Scan(
+			&p.PlayerId,
+			&p.FirstName,
+			&p.LastName,
+			&p.Nationality,
+			&p.Position,
+			&p.Age,
+			&p.Technique,
+			&p.Mental,
+			&p.Physique,
+			&p.InjuryDays,
+			&p.Lined,
+			&p.Benched,
+			&p.Familiarity,
+			&p.Fitness,
+			&p.Happiness,
+			&p.YellowCards,
+			&p.SuspendedMatches,
+		); err != nil {
+			log.Printf("GetSquad: error scanning player: %v", err)
+			return nil, err
+		}
+		squad = append(squad, p)
+	}
+
+	return squad, rows.Err()
+}
@@ -0,0 +1,23 @@
+package lineup
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetTeamFormation(teamID uuid.UUID) (string, error) {
+	var formation string
+	err := r.getTeamFormation.QueryRow(teamID).Scan(&formation)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("team %s: %w", teamID, domain.ErrTeamNotFound)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return formation, nil
+}
@@ -0,0 +1,57 @@
+package lineup
+
+import (
+	"database/sql"
+
+	_ "embed"
+)
+
+//go:embed sql/get_team_formation.sql
+var getTeamFormationQuery string
+
+//go:embed sql/get_squad.sql
+var getSquadQuery string
+
+//go:embed sql/clear_lineup.sql
+var clearLineupQuery string
+
+//go:embed sql/update_lineup_player.sql
+var updateLineupPlayerQuery string
+
+func NewRepository(db *sql.DB) (*Repository, error) {
+	getTeamFormationStmt, err := db.Prepare(getTeamFormationQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getSquadStmt, err := db.Prepare(getSquadQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	clearLineupStmt, err := db.Prepare(clearLineupQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	updateLineupPlayerStmt, err := db.Prepare(updateLineupPlayerQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:                 db,
+		getTeamFormation:   getTeamFormationStmt,
+		getSquad:           getSquadStmt,
+		clearLineup:        clearLineupStmt,
+		updateLineupPlayer: updateLineupPlayerStmt,
+	}, nil
+}
+
+type Repository struct {
+	db                 *sql.DB
+	getTeamFormation   *sql.Stmt
+	getSquad           *sql.Stmt
+	clearLineup        *sql.Stmt
+	updateLineupPlayer *sql.Stmt
+}
@@ -0,0 +1,37 @@
+package lineup
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func (r *Repository) SaveLineup(teamID uuid.UUID, starters, bench []uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Stmt(r.clearLineup).Exec(teamID); err != nil {
+		return fmt.Errorf("error clearing lineup: %w", err)
+	}
+
+	update := tx.Stmt(r.updateLineupPlayer)
+	for _, playerID := range starters {
+		if _, err := update.Exec(playerID, teamID, true, false); err != nil {
+			return fmt.Errorf("error lining up player %s: %w", playerID, err)
+		}
+	}
+	for _, playerID := range bench {
+		if _, err := update.Exec(playerID, teamID, false, true); err != nil {
+			return fmt.Errorf("error benching player %s: %w", playerID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing lineup: %w", err)
+	}
+
+	return nil
+}
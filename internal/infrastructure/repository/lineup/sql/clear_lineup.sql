@@ -0,0 +1,4 @@
+UPDATE oft.player
+SET lined = false,
+    benched = false
+WHERE team_id = $1;
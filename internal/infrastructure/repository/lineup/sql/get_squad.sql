@@ -0,0 +1,21 @@
+SELECT
+    id,
+    firstname,
+    lastname,
+    nationality,
+    position,
+    age,
+    technique,
+    mental,
+    physique,
+    COALESCE(injurydays, 0),
+    COALESCE(lined, false),
+    benched,
+    COALESCE(familiarity, 0),
+    COALESCE(fitness, 0),
+    COALESCE(happiness, 0),
+    yellow_cards,
+    suspended_matches
+FROM oft.player
+WHERE team_id = $1
+ORDER BY lastname, firstname;
@@ -0,0 +1,5 @@
+SELECT
+    COALESCE(s.formation, '')
+FROM oft.team t
+LEFT JOIN oft.strategy s ON s.team_id = t.id
+WHERE t.id = $1;
@@ -0,0 +1,4 @@
+UPDATE oft.player
+SET lined = $3,
+    benched = $4
+WHERE id = $1 AND team_id = $2;
@@ -0,0 +1,36 @@
+package match
+
+import (
+	"time"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetDueMatches(timestamp time.Time) ([]domain.SeasonMatch, error) {
+	rows, err := r.getDueMatches.Query(timestamp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matches []domain.SeasonMatch
+	for rows.Next() {
+		var m domain.SeasonMatch
+		if err := rows.Scan(
+			&m.ID,
+			&m.SeasonID,
+			&m.HomeTeamID,
+			&m.AwayTeamID,
+			&m.MatchDate,
+		); err != nil {
+			return nil, err
+		}
+		matches = append(matches, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
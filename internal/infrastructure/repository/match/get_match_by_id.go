@@ -19,6 +19,16 @@ func (r *Repository) GetMatchByID(matchID uuid.UUID) (domain.SeasonMatch, error)
 		&match.MatchDate,
 		&match.HomeResult,
 		&match.AwayResult,
+		&match.Seed,
+		&match.CupTieID,
+		&match.Leg,
+		&match.ExtraTime,
+		&match.HomePenalties,
+		&match.AwayPenalties,
+		&match.HomeHalfTime,
+		&match.AwayHalfTime,
+		&match.HomeXG,
+		&match.AwayXG,
 	)
 
 	log.Printf("GetMatchByID returned match: ID=%v, HomeResult=%v, AwayResult=%v", match.ID, match.HomeResult, match.AwayResult)
@@ -23,7 +23,13 @@ func (r *Repository) GetMatchEvents(matchID uuid.UUID) ([]domain.MatchEventInfo,
 			&matchEventInfo.TeamId,
 			&matchEventInfo.EventType,
 			&matchEventInfo.Minute,
+			&matchEventInfo.AddedTime,
 			&matchEventInfo.Description,
+			&matchEventInfo.PlayerID,
+			&matchEventInfo.SecondaryPlayerID,
+			&matchEventInfo.OpponentPlayerID,
+			&matchEventInfo.Outcome,
+			&matchEventInfo.XG,
 		)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,33 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetMatchRatings(matchID uuid.UUID) ([]domain.PlayerRating, error) {
+	rows, err := r.getMatchRatings.Query(matchID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving player ratings of match %s: %w", matchID, err)
+	}
+	defer rows.Close()
+
+	var ratings []domain.PlayerRating
+	for rows.Next() {
+		var rating domain.PlayerRating
+		if err := rows.Scan(
+			&rating.MatchID,
+			&rating.PlayerID,
+			&rating.TeamID,
+			&rating.Minutes,
+			&rating.Rating,
+			&rating.ManOfTheMatch,
+		); err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, rating)
+	}
+	return ratings, rows.Err()
+}
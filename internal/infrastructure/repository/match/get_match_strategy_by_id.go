@@ -50,62 +50,101 @@ func (r *Repository) GetMatchStrategyById(matchId uuid.UUID) (*domain.Match, err
 		return nil, err
 	}
 
-	rows, err := r.getMatchPlayers.Query(homeTeam.Id)
+	var err error
+	homeStrategy.TacticalChanges, err = r.getTeamTacticalChanges(homeTeam.Id)
 	if err != nil {
 		return nil, err
 	}
+
+	awayStrategy.TacticalChanges, err = r.getTeamTacticalChanges(awayTeam.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	homeTeam.Players, homeTeam.Bench, err = r.getTeamMatchPlayers(homeTeam.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	awayTeam.Players, awayTeam.Bench, err = r.getTeamMatchPlayers(awayTeam.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	homeStrategy.StrategyTeam = homeTeam
+	awayStrategy.StrategyTeam = awayTeam
+	log.Printf("Total players on local team: %d (+%d on the bench)", len(homeTeam.Players), len(homeTeam.Bench))
+	log.Printf("Total players on visiting team: %d (+%d on the bench)", len(awayTeam.Players), len(awayTeam.Bench))
+
+	m.HomeMatchStrategy = homeStrategy
+	m.AwayMatchStrategy = awayStrategy
+
+	return &m, nil
+}
+
+func (r *Repository) getTeamMatchPlayers(teamID uuid.UUID) ([]domain.Player, []domain.Player, error) {
+	rows, err := r.getMatchPlayers.Query(teamID)
+	if err != nil {
+		return nil, nil, err
+	}
 	defer rows.Close()
 
+	var starters, bench []domain.Player
 	for rows.Next() {
-		log.Println("GetMatchStrategyById: iterating over row of players")
-		var homePlayer domain.Player
+		var p domain.Player
 		if err := rows.Scan(
-			&homePlayer.PlayerId,
-			&homePlayer.FirstName,
-			&homePlayer.LastName,
-			&homePlayer.Position,
-			&homePlayer.Technique,
-			&homePlayer.Mental,
-			&homePlayer.Physique,
+			&p.PlayerId,
+			&p.FirstName,
+			&p.LastName,
+			&p.Position,
+			&p.Technique,
+			&p.Mental,
+			&p.Physique,
+			&p.Lined,
+			&p.Benched,
+			&p.Familiarity,
+			&p.Fitness,
+			&p.Happiness,
 		); err != nil {
 			log.Printf("GetMatchStrategyById: error scanning player: %v", err)
-			return nil, err
+			return nil, nil, err
+		}
+		if p.Lined {
+			starters = append(starters, p)
+		} else {
+			bench = append(bench, p)
 		}
-		log.Printf("Local Player: %+v", homePlayer)
-		homeTeam.Players = append(homeTeam.Players, homePlayer)
 	}
 
-	rows, err = r.getMatchPlayers.Query(awayTeam.Id)
+	return starters, bench, rows.Err()
+}
+
+func (r *Repository) getTeamTacticalChanges(teamID uuid.UUID) ([]domain.TacticalChange, error) {
+	rows, err := r.getTacticalChanges.Query(teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	var changes []domain.TacticalChange
 	for rows.Next() {
-		var awayPlayer domain.Player
+		var c domain.TacticalChange
 		if err := rows.Scan(
-			&awayPlayer.PlayerId,
-			&awayPlayer.FirstName,
-			&awayPlayer.LastName,
-			&awayPlayer.Position,
-			&awayPlayer.Technique,
-			&awayPlayer.Mental,
-			&awayPlayer.Physique,
+			&c.Score,
+			&c.FromMinute,
+			&c.PlayingStyle,
+			&c.GameTempo,
+			&c.PassingStyle,
+			&c.DefensivePositioning,
+			&c.BuildUpPlay,
+			&c.AttackFocus,
+			&c.KeyPlayerUsage,
 		); err != nil {
-			log.Printf("GetMatchStrategyById: error scanning player: %v", err)
+			log.Printf("GetMatchStrategyById: error scanning tactical change: %v", err)
 			return nil, err
 		}
-		log.Printf("Visiting Player: %+v", awayPlayer)
-		awayTeam.Players = append(awayTeam.Players, awayPlayer)
+		changes = append(changes, c)
 	}
 
-	homeStrategy.StrategyTeam = homeTeam
-	awayStrategy.StrategyTeam = awayTeam
-	log.Printf("Total players on local team: %d", len(homeTeam.Players))
-	log.Printf("Total players on visiting team: %d", len(awayTeam.Players))
-
-	m.HomeMatchStrategy = homeStrategy
-	m.AwayMatchStrategy = awayStrategy
-
-	return &m, nil
+	return changes, rows.Err()
 }
@@ -0,0 +1,42 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetMatchTeamStats(matchID uuid.UUID) ([]domain.MatchTeamStats, error) {
+	rows, err := r.getMatchTeamStats.Query(matchID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving team stats of match %s: %w", matchID, err)
+	}
+	defer rows.Close()
+
+	var teamStats []domain.MatchTeamStats
+	for rows.Next() {
+		var stats domain.MatchTeamStats
+		if err := rows.Scan(
+			&stats.MatchID,
+			&stats.TeamID,
+			&stats.BallPossession,
+			&stats.ScoringChances,
+			&stats.Shots,
+			&stats.ShotsOnTarget,
+			&stats.Corners,
+			&stats.Fouls,
+			&stats.YellowCards,
+			&stats.RedCards,
+			&stats.Offsides,
+			&stats.Saves,
+			&stats.Passes,
+			&stats.PassesCompleted,
+		); err != nil {
+			return nil, err
+		}
+		stats.PassSuccess = domain.PassPercentage(stats.PassesCompleted, stats.Passes)
+		teamStats = append(teamStats, stats)
+	}
+	return teamStats, rows.Err()
+}
@@ -0,0 +1,19 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetMatchVenue(matchID uuid.UUID) (domain.Venue, error) {
+	var venue domain.Venue
+	if err := r.getMatchVenue.QueryRow(matchID, domain.DefaultHomeAdvantage).Scan(
+		&venue.HomeAdvantage,
+		&venue.NeutralFinal,
+	); err != nil {
+		return domain.Venue{}, fmt.Errorf("error retrieving venue of match %s: %w", matchID, err)
+	}
+	return venue, nil
+}
@@ -30,6 +30,11 @@ func (r Repository) GetSeasonMatches(seasonID uuid.UUID) ([]domain.SeasonMatch,
 			&matchDate,
 			&homeResult,
 			&awayResult,
+			&m.CupTieID,
+			&m.Leg,
+			&m.ExtraTime,
+			&m.HomePenalties,
+			&m.AwayPenalties,
 		)
 		if err != nil {
 			return nil, err
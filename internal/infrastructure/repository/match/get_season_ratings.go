@@ -0,0 +1,35 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetSeasonRatings(seasonID uuid.UUID) ([]domain.SeasonRating, error) {
+	rows, err := r.getSeasonRatings.Query(seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving player ratings of season %s: %w", seasonID, err)
+	}
+	defer rows.Close()
+
+	var ratings []domain.SeasonRating
+	for rows.Next() {
+		var rating domain.SeasonRating
+		if err := rows.Scan(
+			&rating.PlayerID,
+			&rating.TeamID,
+			&rating.FirstName,
+			&rating.LastName,
+			&rating.Matches,
+			&rating.Minutes,
+			&rating.Rating,
+			&rating.ManOfTheMatch,
+		); err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, rating)
+	}
+	return ratings, rows.Err()
+}
@@ -14,6 +14,12 @@ func (r *Repository) PostMatchEvent(matchEventInfo domain.MatchEventInfo) error
 		matchEventInfo.EventType,
 		matchEventInfo.Minute,
 		matchEventInfo.Description,
+		matchEventInfo.PlayerID,
+		matchEventInfo.SecondaryPlayerID,
+		matchEventInfo.OpponentPlayerID,
+		matchEventInfo.Outcome,
+		matchEventInfo.AddedTime,
+		matchEventInfo.XG,
 	)
 
 	if err != nil {
@@ -1,15 +1,24 @@
 package match
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
+// PostMatches saves the fixtures of a season all at once, so a failure leaves
+// the season without fixtures to be generated again.
 func (r *Repository) PostMatches(matches []domain.SeasonMatch) error {
-	for _, match := range matches {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
 
-		_, err := r.postMatch.Exec(
+	postMatch := tx.Stmt(r.postMatch)
+	for _, match := range matches {
+		_, err := postMatch.Exec(
 			match.SeasonID,
 			match.HomeTeamID,
 			match.AwayTeamID,
@@ -23,5 +32,5 @@ func (r *Repository) PostMatches(matches []domain.SeasonMatch) error {
 		}
 	}
 
-	return nil
+	return tx.Commit()
 }
@@ -16,6 +16,24 @@ var getMatchTeamsQuery string
 //go:embed sql/get_match_strategies.sql
 var getMatchStrategiesQuery string
 
+//go:embed sql/get_tactical_changes.sql
+var getTacticalChangesQuery string
+
+//go:embed sql/post_match_team_stats.sql
+var postMatchTeamStatsQuery string
+
+//go:embed sql/get_match_team_stats.sql
+var getMatchTeamStatsQuery string
+
+//go:embed sql/post_player_match.sql
+var postPlayerMatchQuery string
+
+//go:embed sql/get_match_ratings.sql
+var getMatchRatingsQuery string
+
+//go:embed sql/get_season_ratings.sql
+var getSeasonRatingsQuery string
+
 //go:embed sql/get_match_players.sql
 var getMatchPlayersQuery string
 
@@ -40,6 +58,36 @@ var getMatchEventsQuery string
 //go:embed sql/get_season_matches.sql
 var getSeasonMatchesQuery string
 
+//go:embed sql/upsert_classification.sql
+var upsertClassificationQuery string
+
+//go:embed sql/get_due_matches.sql
+var getDueMatchesQuery string
+
+//go:embed sql/update_cup_tie_winner.sql
+var updateCupTieWinnerQuery string
+
+//go:embed sql/serve_suspensions.sql
+var serveSuspensionsQuery string
+
+//go:embed sql/update_player_discipline.sql
+var updatePlayerDisciplineQuery string
+
+//go:embed sql/recover_injuries.sql
+var recoverInjuriesQuery string
+
+//go:embed sql/update_player_injury.sql
+var updatePlayerInjuryQuery string
+
+//go:embed sql/get_match_venue.sql
+var getMatchVenueQuery string
+
+//go:embed sql/recover_fitness.sql
+var recoverFitnessQuery string
+
+//go:embed sql/update_player_fitness.sql
+var updatePlayerFitnessQuery string
+
 func NewRepository(db *sql.DB) (*Repository, error) {
 	getMatchesStmt, err := db.Prepare(getMatchesQuery)
 	if err != nil {
@@ -96,33 +144,145 @@ func NewRepository(db *sql.DB) (*Repository, error) {
 		return nil, err
 	}
 
+	upsertClassificationStmt, err := db.Prepare(upsertClassificationQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getDueMatchesStmt, err := db.Prepare(getDueMatchesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	updateCupTieWinnerStmt, err := db.Prepare(updateCupTieWinnerQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	serveSuspensionsStmt, err := db.Prepare(serveSuspensionsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	updatePlayerDisciplineStmt, err := db.Prepare(updatePlayerDisciplineQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	recoverInjuriesStmt, err := db.Prepare(recoverInjuriesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	updatePlayerInjuryStmt, err := db.Prepare(updatePlayerInjuryQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	recoverFitnessStmt, err := db.Prepare(recoverFitnessQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	updatePlayerFitnessStmt, err := db.Prepare(updatePlayerFitnessQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getMatchVenueStmt, err := db.Prepare(getMatchVenueQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getTacticalChangesStmt, err := db.Prepare(getTacticalChangesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	postMatchTeamStatsStmt, err := db.Prepare(postMatchTeamStatsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getMatchTeamStatsStmt, err := db.Prepare(getMatchTeamStatsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	postPlayerMatchStmt, err := db.Prepare(postPlayerMatchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getMatchRatingsStmt, err := db.Prepare(getMatchRatingsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getSeasonRatingsStmt, err := db.Prepare(getSeasonRatingsQuery)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Repository{
-		db:                 db,
-		getMatches:         getMatchesStmt,
-		getMatchTeams:      getMatchTeamsStmt,
-		getMatchStrategies: getMatchStrategiesStmt,
-		getMatchPlayers:    getMatchPlayersStmt,
-		postMatch:          postMatchStmt,
-		postMatchEvents:    postMatchEventsStmt,
-		getPendingMatches:  getPendingMatchesStmt,
-		getMatchByID:       getMatchByIDStmt,
-		updateMatch:        updateMatchStmt,
-		getMatchEvents:     getMatchEventsStmt,
-		getSeasonMatches:   getSesaonMatchesStmt,
+		db:                     db,
+		getMatches:             getMatchesStmt,
+		getMatchTeams:          getMatchTeamsStmt,
+		getMatchStrategies:     getMatchStrategiesStmt,
+		getMatchPlayers:        getMatchPlayersStmt,
+		postMatch:              postMatchStmt,
+		postMatchEvents:        postMatchEventsStmt,
+		getPendingMatches:      getPendingMatchesStmt,
+		getMatchByID:           getMatchByIDStmt,
+		updateMatch:            updateMatchStmt,
+		getMatchEvents:         getMatchEventsStmt,
+		getSeasonMatches:       getSesaonMatchesStmt,
+		upsertClassification:   upsertClassificationStmt,
+		getDueMatches:          getDueMatchesStmt,
+		updateCupTieWinner:     updateCupTieWinnerStmt,
+		serveSuspensions:       serveSuspensionsStmt,
+		updatePlayerDiscipline: updatePlayerDisciplineStmt,
+		recoverInjuries:        recoverInjuriesStmt,
+		updatePlayerInjury:     updatePlayerInjuryStmt,
+		recoverFitness:         recoverFitnessStmt,
+		updatePlayerFitness:    updatePlayerFitnessStmt,
+		getMatchVenue:          getMatchVenueStmt,
+		getTacticalChanges:     getTacticalChangesStmt,
+		postMatchTeamStats:     postMatchTeamStatsStmt,
+		getMatchTeamStats:      getMatchTeamStatsStmt,
+		postPlayerMatch:        postPlayerMatchStmt,
+		getMatchRatings:        getMatchRatingsStmt,
+		getSeasonRatings:       getSeasonRatingsStmt,
 	}, nil
 }
 
 type Repository struct {
-	db                 *sql.DB
-	getMatches         *sql.Stmt
-	getMatchTeams      *sql.Stmt
-	getMatchStrategies *sql.Stmt
-	getMatchPlayers    *sql.Stmt
-	postMatch          *sql.Stmt
-	postMatchEvents    *sql.Stmt
-	getPendingMatches  *sql.Stmt
-	getMatchByID       *sql.Stmt
-	updateMatch        *sql.Stmt
-	getMatchEvents     *sql.Stmt
-	getSeasonMatches   *sql.Stmt
+	db                     *sql.DB
+	getMatches             *sql.Stmt
+	getMatchTeams          *sql.Stmt
+	getMatchStrategies     *sql.Stmt
+	getMatchPlayers        *sql.Stmt
+	postMatch              *sql.Stmt
+	postMatchEvents        *sql.Stmt
+	getPendingMatches      *sql.Stmt
+	getMatchByID           *sql.Stmt
+	updateMatch            *sql.Stmt
+	getMatchEvents         *sql.Stmt
+	getSeasonMatches       *sql.Stmt
+	upsertClassification   *sql.Stmt
+	getDueMatches          *sql.Stmt
+	updateCupTieWinner     *sql.Stmt
+	serveSuspensions       *sql.Stmt
+	updatePlayerDiscipline *sql.Stmt
+	recoverInjuries        *sql.Stmt
+	updatePlayerInjury     *sql.Stmt
+	recoverFitness         *sql.Stmt
+	updatePlayerFitness    *sql.Stmt
+	getMatchVenue          *sql.Stmt
+	getTacticalChanges     *sql.Stmt
+	postMatchTeamStats     *sql.Stmt
+	getMatchTeamStats      *sql.Stmt
+	postPlayerMatch        *sql.Stmt
+	getMatchRatings        *sql.Stmt
+	getSeasonRatings       *sql.Stmt
 }
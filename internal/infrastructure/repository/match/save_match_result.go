@@ -0,0 +1,191 @@
+package match
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) SaveMatchResult(record domain.MatchRecord) error {
+	seasonMatch := record.Match
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Stmt(r.updateMatch).Exec(
+		seasonMatch.ID,
+		seasonMatch.HomeResult,
+		seasonMatch.AwayResult,
+		seasonMatch.Seed,
+		seasonMatch.ExtraTime,
+		seasonMatch.HomePenalties,
+		seasonMatch.AwayPenalties,
+		seasonMatch.HomeHalfTime,
+		seasonMatch.AwayHalfTime,
+		seasonMatch.HomeXG,
+		seasonMatch.AwayXG,
+	)
+	if err != nil {
+		log.Print("Error executing UpdateMatch statement:", err)
+		return err
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return domain.ErrMatchAlreadyPlayed
+	}
+
+	postMatchEvent := tx.Stmt(r.postMatchEvents)
+	for _, event := range record.Events {
+		if _, err := postMatchEvent.Exec(
+			event.MatchID,
+			event.TeamId,
+			event.EventType,
+			event.Minute,
+			event.Description,
+			event.PlayerID,
+			event.SecondaryPlayerID,
+			event.OpponentPlayerID,
+			event.Outcome,
+			event.AddedTime,
+			event.XG,
+		); err != nil {
+			log.Print("Error executing PostMatchEvent statement:", err)
+			return err
+		}
+	}
+
+	postMatchTeamStats := tx.Stmt(r.postMatchTeamStats)
+	for _, stats := range record.TeamStats {
+		if _, err := postMatchTeamStats.Exec(
+			stats.MatchID,
+			stats.TeamID,
+			stats.BallPossession,
+			stats.ScoringChances,
+			stats.Shots,
+			stats.ShotsOnTarget,
+			stats.Corners,
+			stats.Fouls,
+			stats.YellowCards,
+			stats.RedCards,
+			stats.Offsides,
+			stats.Saves,
+			stats.Passes,
+			stats.PassesCompleted,
+		); err != nil {
+			log.Printf("Error saving match team stats: %v", err)
+			return err
+		}
+	}
+
+	postPlayerMatch := tx.Stmt(r.postPlayerMatch)
+	for _, rating := range record.Ratings {
+		if _, err := postPlayerMatch.Exec(
+			rating.MatchID,
+			rating.PlayerID,
+			rating.TeamID,
+			rating.Minutes,
+			rating.Rating,
+			rating.ManOfTheMatch,
+		); err != nil {
+			log.Printf("Error saving player rating: %v", err)
+			return err
+		}
+	}
+
+	upsertClassification := tx.Stmt(r.upsertClassification)
+	for _, classification := range record.Classifications {
+		if _, err := upsertClassification.Exec(classificationArgs(classification)...); err != nil {
+			log.Printf("Error upserting classification: %v", err)
+			return err
+		}
+	}
+
+	if record.CupTie != nil && record.CupTie.WinnerTeamID != nil {
+		if _, err := tx.Stmt(r.updateCupTieWinner).Exec(record.CupTie.ID, record.CupTie.WinnerTeamID); err != nil {
+			log.Printf("Error updating cup tie winner: %v", err)
+			return err
+		}
+	}
+
+	if _, err := tx.Stmt(r.serveSuspensions).Exec(seasonMatch.HomeTeamID, seasonMatch.AwayTeamID); err != nil {
+		log.Printf("Error serving suspensions: %v", err)
+		return err
+	}
+
+	updatePlayerDiscipline := tx.Stmt(r.updatePlayerDiscipline)
+	for _, discipline := range record.Discipline {
+		if _, err := updatePlayerDiscipline.Exec(
+			discipline.PlayerID,
+			discipline.YellowCards,
+			discipline.SuspendedMatches,
+			domain.YellowCardsForSuspension,
+		); err != nil {
+			log.Printf("Error updating player discipline: %v", err)
+			return err
+		}
+	}
+
+	if _, err := tx.Stmt(r.recoverInjuries).Exec(seasonMatch.HomeTeamID, seasonMatch.AwayTeamID, domain.RecoveryDaysPerMatchday); err != nil {
+		log.Printf("Error recovering injuries: %v", err)
+		return err
+	}
+
+	updatePlayerInjury := tx.Stmt(r.updatePlayerInjury)
+	for _, injury := range record.Injuries {
+		if _, err := updatePlayerInjury.Exec(injury.PlayerID, injury.Days, injury.Severity); err != nil {
+			log.Printf("Error saving player injury: %v", err)
+			return err
+		}
+	}
+
+	if _, err := tx.Stmt(r.recoverFitness).Exec(seasonMatch.HomeTeamID, seasonMatch.AwayTeamID, domain.FitnessRecoveryPerMatchday); err != nil {
+		log.Printf("Error recovering fitness: %v", err)
+		return err
+	}
+
+	updatePlayerFitness := tx.Stmt(r.updatePlayerFitness)
+	for _, appearance := range record.Appearances {
+		if _, err := updatePlayerFitness.Exec(appearance.PlayerID, appearance.FitnessLoss); err != nil {
+			log.Printf("Error updating player fitness: %v", err)
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func classificationArgs(classification domain.Classification) []any {
+	return []any{
+		classification.SeasonID,
+		classification.TeamID,
+		classification.Points,
+		classification.GoalsFor,
+		classification.GoalsAgainst,
+		classification.Played,
+		classification.Won,
+		classification.Drawn,
+		classification.Lost,
+		classification.Form,
+		classification.Home.Played,
+		classification.Home.Won,
+		classification.Home.Drawn,
+		classification.Home.Lost,
+		classification.Home.GoalsFor,
+		classification.Home.GoalsAgainst,
+		classification.Home.Points,
+		classification.Away.Played,
+		classification.Away.Won,
+		classification.Away.Drawn,
+		classification.Away.Lost,
+		classification.Away.GoalsFor,
+		classification.Away.GoalsAgainst,
+		classification.Away.Points,
+	}
+}
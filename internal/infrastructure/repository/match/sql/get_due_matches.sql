@@ -0,0 +1,11 @@
+SELECT
+			id,
+			season_id,
+			home_team,
+			away_team,
+			match_date
+		FROM oft.match
+		WHERE match_date <= $1
+		AND home_result IS NULL
+		AND away_result IS NULL
+		ORDER BY match_date
@@ -5,6 +5,16 @@ home_team,
 away_team,
 match_date,
 home_result,
-away_result
+away_result,
+seed,
+cup_tie_id,
+COALESCE(leg, 0),
+extra_time,
+home_penalties,
+away_penalties,
+home_half_time_result,
+away_half_time_result,
+home_xg,
+away_xg
 FROM oft.match
 WHERE id=$1;
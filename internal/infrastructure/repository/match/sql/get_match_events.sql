@@ -4,7 +4,13 @@ SELECT
     team_id,
     event_type,
     minute,
-    description
+    added_time,
+    description,
+    player_id,
+    secondary_player_id,
+    opponent_player_id,
+    COALESCE(outcome, ''),
+    xg
 FROM oft.match_events
 WHERE match_id = $1
 ORDER BY created_at ASC;
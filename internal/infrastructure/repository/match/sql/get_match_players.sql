@@ -5,6 +5,14 @@ SELECT
     position,
     technique,
     mental,
-    physique
+    physique,
+    COALESCE(lined, false),
+    benched,
+    COALESCE(familiarity, 0),
+    COALESCE(fitness, 0),
+    COALESCE(happiness, 0)
 FROM oft.player
-WHERE team_id = $1;
+WHERE team_id = $1
+  AND (lined OR benched)
+  AND COALESCE(injurydays, 0) = 0
+  AND suspended_matches = 0;
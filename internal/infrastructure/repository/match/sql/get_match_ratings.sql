@@ -0,0 +1,10 @@
+SELECT
+    match_id,
+    player_id,
+    team_id,
+    minutes,
+    rating,
+    man_of_the_match
+FROM oft.player_match
+WHERE match_id = $1
+ORDER BY rating DESC, minutes DESC;
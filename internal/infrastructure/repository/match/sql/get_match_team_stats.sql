@@ -0,0 +1,17 @@
+SELECT
+    match_id,
+    team_id,
+    ball_possession,
+    scoring_chances,
+    shots,
+    shots_on_target,
+    corners,
+    fouls,
+    yellow_cards,
+    red_cards,
+    offsides,
+    saves,
+    passes,
+    passes_completed
+FROM oft.match_team_stats
+WHERE match_id = $1;
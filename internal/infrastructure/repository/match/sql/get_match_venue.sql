@@ -0,0 +1,8 @@
+SELECT
+    COALESCE(t.home_advantage, c.home_advantage, $2),
+    t.neutral_final
+FROM oft.match m
+JOIN oft.season s ON s.id = m.season_id
+JOIN oft.tournament t ON t.id = s.tournament_id
+JOIN oft.country c ON c.code = t.country_code
+WHERE m.id = $1;
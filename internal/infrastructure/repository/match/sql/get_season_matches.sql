@@ -5,7 +5,11 @@ SELECT
 			away_team,
 			match_date,
 			home_result,
-			away_result
+			away_result,
+			cup_tie_id,
+			COALESCE(leg, 0),
+			extra_time,
+			home_penalties,
+			away_penalties
 		FROM oft.match
 		WHERE season_id = $1
-        
\ No newline at end of file
@@ -0,0 +1,15 @@
+SELECT
+    pm.player_id,
+    pm.team_id,
+    p.firstname,
+    p.lastname,
+    COUNT(*),
+    SUM(pm.minutes),
+    ROUND(AVG(pm.rating), 2),
+    COUNT(*) FILTER (WHERE pm.man_of_the_match)
+FROM oft.player_match pm
+JOIN oft.match m ON m.id = pm.match_id
+JOIN oft.player p ON p.id = pm.player_id
+WHERE m.season_id = $1
+GROUP BY pm.player_id, pm.team_id, p.firstname, p.lastname
+ORDER BY AVG(pm.rating) DESC, COUNT(*) DESC, p.lastname, p.firstname;
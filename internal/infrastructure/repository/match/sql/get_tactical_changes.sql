@@ -0,0 +1,14 @@
+SELECT
+    tc.score,
+    tc.from_minute,
+    COALESCE(tc.playing_style, ''),
+    COALESCE(tc.game_tempo, ''),
+    COALESCE(tc.passing_style, ''),
+    COALESCE(tc.defensive_positioning, ''),
+    COALESCE(tc.build_up_play, ''),
+    COALESCE(tc.attack_focus, ''),
+    COALESCE(tc.key_player_usage, '')
+FROM oft.tactical_change tc
+JOIN oft.strategy s ON s.id = tc.strategy_id
+WHERE s.team_id = $1
+ORDER BY tc.from_minute;
@@ -3,7 +3,13 @@ INSERT INTO oft.match_events (
     team_id,
     event_type,
     minute,
-    description
+    description,
+    player_id,
+    secondary_player_id,
+    opponent_player_id,
+    outcome,
+    added_time,
+    xg
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), $10, $11
 );
@@ -0,0 +1,18 @@
+INSERT INTO oft.match_team_stats (
+    match_id,
+    team_id,
+    ball_possession,
+    scoring_chances,
+    shots,
+    shots_on_target,
+    corners,
+    fouls,
+    yellow_cards,
+    red_cards,
+    offsides,
+    saves,
+    passes,
+    passes_completed
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+);
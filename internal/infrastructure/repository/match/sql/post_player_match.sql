@@ -0,0 +1,10 @@
+INSERT INTO oft.player_match (
+    match_id,
+    player_id,
+    team_id,
+    minutes,
+    rating,
+    man_of_the_match
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
@@ -0,0 +1,3 @@
+UPDATE oft.player
+SET fitness = LEAST(COALESCE(fitness, 100) + $3, 100)
+WHERE team_id IN ($1, $2);
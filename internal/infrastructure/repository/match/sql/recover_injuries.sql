@@ -0,0 +1,5 @@
+UPDATE oft.player
+SET injurydays = GREATEST(injurydays - $3, 0),
+    injury = CASE WHEN injurydays > $3 THEN injury END
+WHERE team_id IN ($1, $2)
+  AND injurydays > 0;
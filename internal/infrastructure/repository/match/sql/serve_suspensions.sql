@@ -0,0 +1,4 @@
+UPDATE oft.player
+SET suspended_matches = suspended_matches - 1
+WHERE team_id IN ($1, $2)
+  AND suspended_matches > 0;
@@ -0,0 +1,4 @@
+UPDATE oft.cup_tie
+SET winner_team = $2
+WHERE id = $1
+  AND winner_team IS NULL;
@@ -1,5 +1,15 @@
 UPDATE oft.match
 SET
   home_result = $2,
-  away_result = $3
-WHERE id = $1;
+  away_result = $3,
+  seed = $4,
+  extra_time = $5,
+  home_penalties = $6,
+  away_penalties = $7,
+  home_half_time_result = $8,
+  away_half_time_result = $9,
+  home_xg = $10,
+  away_xg = $11
+WHERE id = $1
+  AND home_result IS NULL
+  AND away_result IS NULL;
@@ -0,0 +1,4 @@
+UPDATE oft.player
+SET yellow_cards = (yellow_cards + $2) % $4,
+    suspended_matches = suspended_matches + $3 + (yellow_cards + $2) / $4
+WHERE id = $1;
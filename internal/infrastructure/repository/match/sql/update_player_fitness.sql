@@ -0,0 +1,3 @@
+UPDATE oft.player
+SET fitness = GREATEST(COALESCE(fitness, 100) - $2, 1)
+WHERE id = $1;
@@ -0,0 +1,5 @@
+UPDATE oft.player
+SET injurydays = $2,
+    injury = $3
+WHERE id = $1
+  AND COALESCE(injurydays, 0) < $2;
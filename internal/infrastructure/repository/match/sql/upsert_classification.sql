@@ -0,0 +1,51 @@
+INSERT INTO oft.classification (
+  season_id,
+  team_id,
+  points,
+  goals_for,
+  goals_against,
+  played,
+  won,
+  drawn,
+  lost,
+  form,
+  home_played,
+  home_won,
+  home_drawn,
+  home_lost,
+  home_goals_for,
+  home_goals_against,
+  home_points,
+  away_played,
+  away_won,
+  away_drawn,
+  away_lost,
+  away_goals_for,
+  away_goals_against,
+  away_points
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
+ON CONFLICT (season_id, team_id) DO UPDATE
+SET
+  points = oft.classification.points + EXCLUDED.points,
+  goals_for = oft.classification.goals_for + EXCLUDED.goals_for,
+  goals_against = oft.classification.goals_against + EXCLUDED.goals_against,
+  played = oft.classification.played + EXCLUDED.played,
+  won = oft.classification.won + EXCLUDED.won,
+  drawn = oft.classification.drawn + EXCLUDED.drawn,
+  lost = oft.classification.lost + EXCLUDED.lost,
+  form = RIGHT(oft.classification.form || EXCLUDED.form, 5),
+  home_played = oft.classification.home_played + EXCLUDED.home_played,
+  home_won = oft.classification.home_won + EXCLUDED.home_won,
+  home_drawn = oft.classification.home_drawn + EXCLUDED.home_drawn,
+  home_lost = oft.classification.home_lost + EXCLUDED.home_lost,
+  home_goals_for = oft.classification.home_goals_for + EXCLUDED.home_goals_for,
+  home_goals_against = oft.classification.home_goals_against + EXCLUDED.home_goals_against,
+  home_points = oft.classification.home_points + EXCLUDED.home_points,
+  away_played = oft.classification.away_played + EXCLUDED.away_played,
+  away_won = oft.classification.away_won + EXCLUDED.away_won,
+  away_drawn = oft.classification.away_drawn + EXCLUDED.away_drawn,
+  away_lost = oft.classification.away_lost + EXCLUDED.away_lost,
+  away_goals_for = oft.classification.away_goals_for + EXCLUDED.away_goals_for,
+  away_goals_against = oft.classification.away_goals_against + EXCLUDED.away_goals_against,
+  away_points = oft.classification.away_points + EXCLUDED.away_points;
@@ -0,0 +1,44 @@
+package match
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetTeamInjuries(teamID uuid.UUID) ([]domain.Player, error) {
+	var exists bool
+	if err := r.getTeamExists.QueryRow(teamID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("error checking team %s: %w", teamID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("team %s: %w", teamID, domain.ErrTeamNotFound)
+	}
+
+	rows, err := r.getTeamInjuries.Query(teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var injured []domain.Player
+	for rows.Next() {
+		var p domain.Player
+		if err := rows.Scan(
+			&p.PlayerId,
+			&p.FirstName,
+			&p.LastName,
+			&p.Position,
+			&p.InjuryDays,
+			&p.Injury,
+		); err != nil {
+			log.Printf("GetTeamInjuries: error scanning player: %v", err)
+			return nil, err
+		}
+		injured = append(injured, p)
+	}
+
+	return injured, rows.Err()
+}
@@ -0,0 +1,58 @@
+package match
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) PostSquad(teamID uuid.UUID, players []domain.Player) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.Stmt(r.getTeamExists).QueryRow(teamID).Scan(&exists); err != nil {
+		return fmt.Errorf("error checking team %s: %w", teamID, err)
+	}
+	if !exists {
+		return fmt.Errorf("team %s: %w", teamID, domain.ErrTeamNotFound)
+	}
+
+	stmt := tx.Stmt(r.postSquadPlayer)
+	for _, player := range players {
+		_, err := stmt.Exec(
+			player.PlayerId,
+			teamID,
+			player.FirstName,
+			player.LastName,
+			player.Nationality,
+			player.Position,
+			player.Age,
+			player.Fee,
+			player.Salary,
+			player.Technique,
+			player.Mental,
+			player.Physique,
+			player.InjuryDays,
+			player.Lined,
+			player.Familiarity,
+			player.Fitness,
+			player.Happiness,
+		)
+		if err != nil {
+			log.Print("Error executing PostSquad statement:", err)
+			return fmt.Errorf("error inserting player %s %s: %w", player.FirstName, player.LastName, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing squad: %w", err)
+	}
+
+	return nil
+}
@@ -9,19 +9,49 @@ import (
 //go:embed sql/post_player.sql
 var postPlayerQuery string
 
+//go:embed sql/post_squad_player.sql
+var postSquadPlayerQuery string
+
+//go:embed sql/get_team_exists.sql
+var getTeamExistsQuery string
+
+//go:embed sql/get_team_injuries.sql
+var getTeamInjuriesQuery string
+
 func NewRepository(db *sql.DB) (*Repository, error) {
 	postPlayerStmt, err := db.Prepare(postPlayerQuery)
 	if err != nil {
 		return nil, err
 	}
 
+	postSquadPlayerStmt, err := db.Prepare(postSquadPlayerQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getTeamExistsStmt, err := db.Prepare(getTeamExistsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getTeamInjuriesStmt, err := db.Prepare(getTeamInjuriesQuery)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Repository{
-		db:         db,
-		postPlayer: postPlayerStmt,
+		db:              db,
+		postPlayer:      postPlayerStmt,
+		postSquadPlayer: postSquadPlayerStmt,
+		getTeamExists:   getTeamExistsStmt,
+		getTeamInjuries: getTeamInjuriesStmt,
 	}, nil
 }
 
 type Repository struct {
-	db         *sql.DB
-	postPlayer *sql.Stmt
+	db              *sql.DB
+	postPlayer      *sql.Stmt
+	postSquadPlayer *sql.Stmt
+	getTeamExists   *sql.Stmt
+	getTeamInjuries *sql.Stmt
 }
@@ -0,0 +1,3 @@
+SELECT EXISTS (
+    SELECT 1 FROM oft.team WHERE id = $1
+);
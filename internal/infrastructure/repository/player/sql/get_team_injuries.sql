@@ -0,0 +1,11 @@
+SELECT
+    id,
+    firstname,
+    lastname,
+    position,
+    injurydays,
+    COALESCE(injury, '')
+FROM oft.player
+WHERE team_id = $1
+  AND injurydays > 0
+ORDER BY injurydays DESC, lastname, firstname;
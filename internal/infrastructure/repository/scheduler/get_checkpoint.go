@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"database/sql"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetCheckpoint(name string) (*domain.SchedulerCheckpoint, error) {
+	var checkpoint domain.SchedulerCheckpoint
+	err := r.getCheckpoint.QueryRow(name).Scan(
+		&checkpoint.Name,
+		&checkpoint.LastRunAt,
+		&checkpoint.Played,
+		&checkpoint.Failed,
+		&checkpoint.Skipped,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"database/sql"
+
+	_ "embed"
+)
+
+//go:embed sql/get_checkpoint.sql
+var getCheckpointQuery string
+
+//go:embed sql/save_checkpoint.sql
+var saveCheckpointQuery string
+
+func NewRepository(db *sql.DB) (*Repository, error) {
+	getCheckpointStmt, err := db.Prepare(getCheckpointQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	saveCheckpointStmt, err := db.Prepare(saveCheckpointQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:             db,
+		getCheckpoint:  getCheckpointStmt,
+		saveCheckpoint: saveCheckpointStmt,
+	}, nil
+}
+
+type Repository struct {
+	db             *sql.DB
+	getCheckpoint  *sql.Stmt
+	saveCheckpoint *sql.Stmt
+}
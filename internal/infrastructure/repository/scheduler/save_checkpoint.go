@@ -0,0 +1,22 @@
+package scheduler
+
+import (
+	"log"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) SaveCheckpoint(checkpoint domain.SchedulerCheckpoint) error {
+	_, err := r.saveCheckpoint.Exec(
+		checkpoint.Name,
+		checkpoint.LastRunAt,
+		checkpoint.Played,
+		checkpoint.Failed,
+		checkpoint.Skipped,
+	)
+	if err != nil {
+		log.Printf("Error saving scheduler checkpoint: %v", err)
+		return err
+	}
+	return nil
+}
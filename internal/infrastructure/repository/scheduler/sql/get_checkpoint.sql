@@ -0,0 +1,8 @@
+SELECT
+    name,
+    last_run_at,
+    played,
+    failed,
+    skipped
+FROM oft.scheduler_checkpoint
+WHERE name = $1;
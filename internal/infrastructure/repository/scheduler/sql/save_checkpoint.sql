@@ -0,0 +1,9 @@
+INSERT INTO oft.scheduler_checkpoint (name, last_run_at, played, failed, skipped, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (name) DO UPDATE
+SET
+    last_run_at = EXCLUDED.last_run_at,
+    played = EXCLUDED.played,
+    failed = EXCLUDED.failed,
+    skipped = EXCLUDED.skipped,
+    updated_at = NOW();
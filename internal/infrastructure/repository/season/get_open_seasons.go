@@ -0,0 +1,36 @@
+package season
+
+import (
+	"time"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetOpenSeasons(countryCode string, fromDate time.Time) ([]domain.Season, error) {
+	rows, err := r.getOpenSeasons.Query(countryCode, fromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seasons []domain.Season
+	for rows.Next() {
+		var season domain.Season
+		if err := rows.Scan(
+			&season.ID,
+			&season.TournamentID,
+			&season.FromDate,
+			&season.ToDate,
+			&season.Closed,
+		); err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, season)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return seasons, nil
+}
@@ -0,0 +1,20 @@
+package season
+
+import (
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) GetSeason(seasonID uuid.UUID) (domain.Season, error) {
+	var season domain.Season
+	if err := r.getSeason.QueryRow(seasonID).Scan(
+		&season.ID,
+		&season.TournamentID,
+		&season.FromDate,
+		&season.ToDate,
+		&season.Closed,
+	); err != nil {
+		return domain.Season{}, err
+	}
+	return season, nil
+}
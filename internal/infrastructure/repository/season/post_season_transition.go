@@ -0,0 +1,50 @@
+package season
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+func (r *Repository) PostSeasonTransition(closedSeasons []uuid.UUID, nextSeasons []domain.Season, seasonTeams map[uuid.UUID][]uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	closeSeason := tx.Stmt(r.closeSeason)
+	for _, seasonID := range closedSeasons {
+		res, err := closeSeason.Exec(seasonID)
+		if err != nil {
+			log.Printf("Error closing season %s: %v", seasonID, err)
+			return err
+		}
+		closed, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if closed == 0 {
+			return fmt.Errorf("season %s: %w", seasonID, domain.ErrSeasonClosed)
+		}
+	}
+
+	postSeason := tx.Stmt(r.postSeason)
+	postSeasonTeam := tx.Stmt(r.postSeasonTeam)
+	for _, season := range nextSeasons {
+		if _, err := postSeason.Exec(season.ID, season.TournamentID, season.FromDate, season.ToDate); err != nil {
+			log.Printf("Error inserting season for tournament %s: %v", season.TournamentID, err)
+			return err
+		}
+		for _, teamID := range seasonTeams[season.ID] {
+			if _, err := postSeasonTeam.Exec(season.ID, teamID); err != nil {
+				log.Printf("Error inserting team %s in season %s: %v", teamID, season.ID, err)
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,67 @@
+package season
+
+import (
+	"database/sql"
+
+	_ "embed"
+)
+
+//go:embed sql/get_season.sql
+var getSeasonQuery string
+
+//go:embed sql/get_open_seasons.sql
+var getOpenSeasonsQuery string
+
+//go:embed sql/close_season.sql
+var closeSeasonQuery string
+
+//go:embed sql/post_season.sql
+var postSeasonQuery string
+
+//go:embed sql/post_season_team.sql
+var postSeasonTeamQuery string
+
+func NewRepository(db *sql.DB) (*Repository, error) {
+	getSeasonStmt, err := db.Prepare(getSeasonQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	getOpenSeasonsStmt, err := db.Prepare(getOpenSeasonsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	closeSeasonStmt, err := db.Prepare(closeSeasonQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	postSeasonStmt, err := db.Prepare(postSeasonQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	postSeasonTeamStmt, err := db.Prepare(postSeasonTeamQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:             db,
+		getSeason:      getSeasonStmt,
+		getOpenSeasons: getOpenSeasonsStmt,
+		closeSeason:    closeSeasonStmt,
+		postSeason:     postSeasonStmt,
+		postSeasonTeam: postSeasonTeamStmt,
+	}, nil
+}
+
+type Repository struct {
+	db             *sql.DB
+	getSeason      *sql.Stmt
+	getOpenSeasons *sql.Stmt
+	closeSeason    *sql.Stmt
+	postSeason     *sql.Stmt
+	postSeasonTeam *sql.Stmt
+}
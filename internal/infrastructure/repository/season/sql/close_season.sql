@@ -0,0 +1,4 @@
+UPDATE oft.season
+SET closed = TRUE
+WHERE id = $1
+  AND closed = FALSE;
@@ -0,0 +1,12 @@
+SELECT
+    s.id,
+    s.tournament_id,
+    s.from_date,
+    s.to_date,
+    s.closed
+FROM oft.season s
+JOIN oft.tournament t ON s.tournament_id = t.id
+WHERE t.country_code = $1
+  AND s.from_date = $2
+  AND s.closed = FALSE
+ORDER BY t.type DESC, t.division;
@@ -0,0 +1,8 @@
+SELECT
+    id,
+    tournament_id,
+    from_date,
+    to_date,
+    closed
+FROM oft.season
+WHERE id = $1;
@@ -0,0 +1,2 @@
+INSERT INTO oft.season (id, tournament_id, from_date, to_date)
+VALUES ($1, $2, $3, $4);
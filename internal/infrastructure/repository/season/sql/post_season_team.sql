@@ -0,0 +1,2 @@
+INSERT INTO oft.season_team (season_id, team_id)
+VALUES ($1, $2);
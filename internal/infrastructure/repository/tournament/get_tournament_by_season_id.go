@@ -2,12 +2,14 @@ package tournament
 
 import (
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
 func (r *Repository) GetTournamentBySeasonID(seasonId uuid.UUID) (domain.Tournament, error) {
 	row := r.getTournamentBySeasonID.QueryRow(seasonId)
 	var tournament domain.Tournament
+	var tieBreakers []string
 	if err := row.Scan(
 		&tournament.ID,
 		&tournament.Name,
@@ -16,8 +18,14 @@ func (r *Repository) GetTournamentBySeasonID(seasonId uuid.UUID) (domain.Tournam
 		&tournament.Division,
 		&tournament.PromotionTo,
 		&tournament.DescentTo,
+		pq.Array(&tieBreakers),
+		&tournament.TwoLegged,
+		&tournament.PromotionSpots,
+		&tournament.RelegationSpots,
+		&tournament.PlayoffSpots,
 	); err != nil {
 		return domain.Tournament{}, err
 	}
+	tournament.TieBreakers = toTieBreakers(tieBreakers)
 	return tournament, nil
 }
@@ -1,6 +1,7 @@
 package tournament
 
 import (
+	"github.com/lib/pq"
 	"github.com/robertobouses/online-football-tycoon/internal/domain"
 )
 
@@ -15,6 +16,7 @@ func (r *Repository) GetTournamentsByCountry(country string) ([]domain.Tournamen
 
 	for rows.Next() {
 		var tournament domain.Tournament
+		var tieBreakers []string
 		if err := rows.Scan(
 			&tournament.ID,
 			&tournament.Name,
@@ -23,9 +25,15 @@ func (r *Repository) GetTournamentsByCountry(country string) ([]domain.Tournamen
 			&tournament.Division,
 			&tournament.PromotionTo,
 			&tournament.DescentTo,
+			pq.Array(&tieBreakers),
+			&tournament.TwoLegged,
+			&tournament.PromotionSpots,
+			&tournament.RelegationSpots,
+			&tournament.PlayoffSpots,
 		); err != nil {
 			return nil, err
 		}
+		tournament.TieBreakers = toTieBreakers(tieBreakers)
 		tournaments = append(tournaments, tournament)
 	}
 
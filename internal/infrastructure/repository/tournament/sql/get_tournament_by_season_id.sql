@@ -5,7 +5,12 @@ SELECT
     t.country_code,
     t.division,
     t.promotion_to,
-    t.descent_to
+    t.descent_to,
+    t.tie_breakers,
+    t.two_legged,
+    t.promotion_spots,
+    t.relegation_spots,
+    t.playoff_spots
 FROM oft.season s
 JOIN oft.tournament t ON s.tournament_id = t.id
 WHERE s.id = $1;
@@ -5,6 +5,11 @@ SELECT
     t.country_code,
     t.division,
     t.promotion_to,
-    t.descent_to
+    t.descent_to,
+    t.tie_breakers,
+    t.two_legged,
+    t.promotion_spots,
+    t.relegation_spots,
+    t.playoff_spots
 FROM oft.tournament t
 WHERE t.country_code = $1;
@@ -0,0 +1,11 @@
+package tournament
+
+import "github.com/robertobouses/online-football-tycoon/internal/domain"
+
+func toTieBreakers(values []string) []domain.TieBreaker {
+	tieBreakers := make([]domain.TieBreaker, 0, len(values))
+	for _, v := range values {
+		tieBreakers = append(tieBreakers, domain.TieBreaker(v))
+	}
+	return tieBreakers
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	appMatch "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/match"
+	appScheduler "github.com/robertobouses/online-football-tycoon/internal/domain/use_cases/scheduler"
+)
+
+// Scheduler reads the settings of the matchday scheduler, shared by the
+// scheduler command and the server.
+func Scheduler() appScheduler.Config {
+	return appScheduler.Config{
+		Interval:   durationFromEnv("SCHEDULER_INTERVAL", time.Minute),
+		Workers:    intFromEnv("SCHEDULER_WORKERS", 4),
+		MaxRetries: intFromEnv("SCHEDULER_MAX_RETRIES", 3),
+		RetryDelay: durationFromEnv("SCHEDULER_RETRY_DELAY", 2*time.Second),
+	}
+}
+
+// MatchEngine reads the engine matches are played with, the event budget one
+// by default.
+func MatchEngine() appMatch.Engine {
+	engine, err := appMatch.ParseEngine(os.Getenv("MATCH_ENGINE"))
+	if err != nil {
+		log.Printf("%v, using %s", err, appMatch.EngineEventBudget)
+		return appMatch.EngineEventBudget
+	}
+	return engine
+}
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("invalid %s=%q, using %s", key, value, fallback)
+		return fallback
+	}
+	return d
+}
+
+func intFromEnv(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("invalid %s=%q, using %d", key, value, fallback)
+		return fallback
+	}
+	return n
+}
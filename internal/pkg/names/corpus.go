@@ -0,0 +1,88 @@
+package names
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+)
+
+//go:embed corpus/names.json
+var namesJSON []byte
+
+//go:embed corpus/country_continent.json
+var countryContinentJSON []byte
+
+const fallbackContinent = "EUROPE"
+
+type namePool struct {
+	FirstNames []string `json:"first_names"`
+	LastNames  []string `json:"last_names"`
+}
+
+type corpus struct {
+	Countries  map[string]namePool `json:"countries"`
+	Continents map[string]namePool `json:"continents"`
+}
+
+// CorpusGenerator picks names from the embedded corpus. Countries without their
+// own pool use the pool of their continent. The same seed yields the same names.
+type CorpusGenerator struct {
+	corpus           corpus
+	countryContinent map[string]string
+	mu               sync.Mutex
+	rng              *rand.Rand
+}
+
+func NewCorpusGenerator(seed uint64) (*CorpusGenerator, error) {
+	var c corpus
+	if err := json.Unmarshal(namesJSON, &c); err != nil {
+		return nil, fmt.Errorf("error loading names corpus: %w", err)
+	}
+
+	var countryContinent map[string]string
+	if err := json.Unmarshal(countryContinentJSON, &countryContinent); err != nil {
+		return nil, fmt.Errorf("error loading country continents: %w", err)
+	}
+
+	return &CorpusGenerator{
+		corpus:           c,
+		countryContinent: countryContinent,
+		rng:              rand.New(rand.NewPCG(seed, seed)),
+	}, nil
+}
+
+func (g *CorpusGenerator) GenerateName(country string) (string, string, error) {
+	pool, err := g.pool(country)
+	if err != nil {
+		return "", "", err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	firstName := pool.FirstNames[g.rng.IntN(len(pool.FirstNames))]
+	lastName := pool.LastNames[g.rng.IntN(len(pool.LastNames))]
+
+	return firstName, lastName, nil
+}
+
+func (g *CorpusGenerator) pool(country string) (namePool, error) {
+	if pool, ok := g.corpus.Countries[country]; ok {
+		return pool, nil
+	}
+
+	continent, ok := g.countryContinent[country]
+	if !ok {
+		return namePool{}, fmt.Errorf("%w %q", domain.ErrUnknownCountry, country)
+	}
+
+	if pool, ok := g.corpus.Continents[continent]; ok {
+		return pool, nil
+	}
+
+	return g.corpus.Continents[fallbackContinent], nil
+}
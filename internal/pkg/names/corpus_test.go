@@ -0,0 +1,49 @@
+package names_test
+
+import (
+	"testing"
+
+	"github.com/robertobouses/online-football-tycoon/internal/domain"
+	"github.com/robertobouses/online-football-tycoon/internal/pkg/names"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorpusGeneratorIsDeterministic(t *testing.T) {
+	first, err := names.NewCorpusGenerator(42)
+	require.NoError(t, err)
+	second, err := names.NewCorpusGenerator(42)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		firstName, lastName, err := first.GenerateName("ESP")
+		require.NoError(t, err)
+		otherFirstName, otherLastName, err := second.GenerateName("ESP")
+		require.NoError(t, err)
+
+		assert.Equal(t, firstName, otherFirstName)
+		assert.Equal(t, lastName, otherLastName)
+	}
+}
+
+func TestCorpusGeneratorFallsBackToContinent(t *testing.T) {
+	generator, err := names.NewCorpusGenerator(1)
+	require.NoError(t, err)
+
+	for _, country := range []string{"ESP", "ISL", "GHA", "NZL", "CRI", "PER", "VNM", "CAN"} {
+		firstName, lastName, err := generator.GenerateName(country)
+
+		assert.NoError(t, err, country)
+		assert.NotEmpty(t, firstName, country)
+		assert.NotEmpty(t, lastName, country)
+	}
+}
+
+func TestCorpusGeneratorRejectsUnknownCountry(t *testing.T) {
+	generator, err := names.NewCorpusGenerator(1)
+	require.NoError(t, err)
+
+	_, _, err = generator.GenerateName("XXX")
+
+	assert.ErrorIs(t, err, domain.ErrUnknownCountry)
+}
@@ -0,0 +1,5 @@
+package names
+
+type Generator interface {
+	GenerateName(country string) (string, string, error)
+}
@@ -1,23 +1,67 @@
-package player
+package names
 
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"net/http"
 	"net/url"
 )
 
-func GetRandomNameByCountry(country string) (string, string, error) {
-	baseURL := "https://randomuser.me/api/"
-	params := url.Values{}
+const randomUserURL = "https://randomuser.me/api/"
+
+// randomUserNationalities maps ISO alpha-3 codes to the nationalities supported by randomuser.me.
+var randomUserNationalities = map[string]string{
+	"AUS": "AU",
+	"BRA": "BR",
+	"CAN": "CA",
+	"CHE": "CH",
+	"DEU": "DE",
+	"DNK": "DK",
+	"ESP": "ES",
+	"FIN": "FI",
+	"FRA": "FR",
+	"GBR": "GB",
+	"IND": "IN",
+	"IRL": "IE",
+	"IRN": "IR",
+	"MEX": "MX",
+	"NLD": "NL",
+	"NOR": "NO",
+	"NZL": "NZ",
+	"SRB": "RS",
+	"TUR": "TR",
+	"UKR": "UA",
+	"USA": "US",
+}
+
+// RandomUserGenerator asks randomuser.me for a name. Countries the API does not
+// support are delegated to the fallback generator.
+type RandomUserGenerator struct {
+	client   *http.Client
+	fallback Generator
+}
+
+func NewRandomUserGenerator(client *http.Client, fallback Generator) RandomUserGenerator {
+	return RandomUserGenerator{
+		client:   client,
+		fallback: fallback,
+	}
+}
 
-	params.Add("nat", country)
+func (g RandomUserGenerator) GenerateName(country string) (string, string, error) {
+	nat, ok := randomUserNationalities[country]
+	if !ok {
+		return g.fallback.GenerateName(country)
+	}
+
+	params := url.Values{}
+	params.Add("nat", nat)
 	params.Add("gender", "male")
 	params.Add("results", "1")
 	params.Add("cloudflare", "robertobouses")
-	apiURL := fmt.Sprintf("%s?%s", baseURL, params.Encode())
+	apiURL := fmt.Sprintf("%s?%s", randomUserURL, params.Encode())
 
 	req, err := http.NewRequest("GET", apiURL, nil)
 	if err != nil {
@@ -26,7 +70,7 @@ func GetRandomNameByCountry(country string) (string, string, error) {
 	}
 	req.Header.Set("User-Agent", "Go-http-client/1.1")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := g.client.Do(req)
 	if err != nil {
 		log.Printf("Error making HTTP request: %v", err)
 		return "", "", err
@@ -38,7 +82,7 @@ func GetRandomNameByCountry(country string) (string, string, error) {
 		return "", "", fmt.Errorf("API returned status code %d", resp.StatusCode)
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Printf("Error reading response body: %v", err)
 		return "", "", err
@@ -49,8 +93,6 @@ func GetRandomNameByCountry(country string) (string, string, error) {
 		return "", "", fmt.Errorf("non-JSON response")
 	}
 
-	log.Printf("API response: %s", string(body))
-
 	var userName RandomUserName
 	if err := json.Unmarshal(body, &userName); err != nil {
 		log.Printf("Error unmarshalling JSON: %v", err)
@@ -61,10 +103,7 @@ func GetRandomNameByCountry(country string) (string, string, error) {
 		return "", "", fmt.Errorf("no results in API response")
 	}
 
-	firstName := userName.Results[0].Name.First
-	lastName := userName.Results[0].Name.Last
-
-	return firstName, lastName, nil
+	return userName.Results[0].Name.First, userName.Results[0].Name.Last, nil
 }
 
 type RandomUserName struct {
@@ -76,10 +115,3 @@ type RandomUserName struct {
 		Nat string `json:"nat"`
 	} `json:"results"`
 }
-
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}